@@ -0,0 +1,112 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/cluster/backups"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtClusterBackupStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtClusterBackupStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"operation_type": {
+				Type:        schema.TypeString,
+				Description: "Type of backup operation in progress, NONE if no operation is in progress",
+				Computed:    true,
+			},
+			"current_step": {
+				Type:        schema.TypeString,
+				Description: "Current step of backup operation in progress",
+				Computed:    true,
+			},
+			"last_backup": {
+				Type:        schema.TypeList,
+				Description: "Status of last cluster backup",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:        schema.TypeString,
+							Description: "Unique identifier of the backup",
+							Computed:    true,
+						},
+						"success": {
+							Type:        schema.TypeBool,
+							Description: "Whether the backup completed successfully",
+							Computed:    true,
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Description: "Time when backup was started",
+							Computed:    true,
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Description: "Time when backup ended",
+							Computed:    true,
+						},
+						"error_code": {
+							Type:        schema.TypeString,
+							Description: "Error code for failed backup",
+							Computed:    true,
+						},
+						"error_message": {
+							Type:        schema.TypeString,
+							Description: "Error details for failed backup",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtClusterBackupStatusRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	status, err := backups.NewStatusClient(connector).Get()
+	if err != nil {
+		return handleDataSourceReadError(d, "Cluster Backup Status", "", err)
+	}
+
+	history, err := backups.NewHistoryClient(connector).Get()
+	if err != nil {
+		return handleDataSourceReadError(d, "Cluster Backup History", "", err)
+	}
+
+	d.Set("operation_type", status.OperationType)
+	d.Set("current_step", status.CurrentStep)
+
+	var lastBackup *model.BackupOperationStatus
+	for i, backup := range history.ClusterBackupStatuses {
+		if backup.StartTime == nil {
+			continue
+		}
+		if lastBackup == nil || *backup.StartTime > *lastBackup.StartTime {
+			lastBackup = &history.ClusterBackupStatuses[i]
+		}
+	}
+
+	var lastBackupList []interface{}
+	if lastBackup != nil {
+		elem := make(map[string]interface{})
+		elem["backup_id"] = lastBackup.BackupId
+		elem["success"] = lastBackup.Success
+		elem["start_time"] = formatEpochMillisTime(lastBackup.StartTime)
+		elem["end_time"] = formatEpochMillisTime(lastBackup.EndTime)
+		elem["error_code"] = lastBackup.ErrorCode
+		elem["error_message"] = lastBackup.ErrorMessage
+		lastBackupList = append(lastBackupList, elem)
+	}
+	d.Set("last_backup", lastBackupList)
+
+	d.SetId(newUUID())
+
+	return nil
+}
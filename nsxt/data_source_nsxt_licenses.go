@@ -0,0 +1,70 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtLicenses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtLicensesRead,
+
+		Schema: map[string]*schema.Schema{
+			"license": {
+				Type:        schema.TypeList,
+				Description: "Licenses applied to NSX",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"license_key": {
+							Type:        schema.TypeString,
+							Description: "License key",
+							Computed:    true,
+						},
+						"edition":         getLicenseAttributeSchema(schema.TypeString, "License edition"),
+						"capacity_type":   getLicenseAttributeSchema(schema.TypeString, "License metric"),
+						"quantity":        getLicenseAttributeSchema(schema.TypeInt, "License capacity, 0 for unlimited"),
+						"expiry":          getLicenseAttributeSchema(schema.TypeString, "License expiry date"),
+						"is_eval":         getLicenseAttributeSchema(schema.TypeBool, "Whether this is an evaluation license"),
+						"is_expired":      getLicenseAttributeSchema(schema.TypeBool, "Whether the license has expired"),
+						"product_name":    getLicenseAttributeSchema(schema.TypeString, "Product name"),
+						"product_version": getLicenseAttributeSchema(schema.TypeString, "Product version"),
+						"features":        getLicenseAttributeSchema(schema.TypeString, "Semicolon delimited feature list"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtLicensesRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return dataSourceNotSupportedError()
+	}
+
+	licenses, _, err := nsxClient.LicensingApi.GetLicenses(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error while reading licenses: %v", err)
+	}
+
+	var licenseList []map[string]interface{}
+	for _, license := range licenses.Results {
+		elem := getLicenseAttributes(license)
+		elem["license_key"] = license.LicenseKey
+		licenseList = append(licenseList, elem)
+	}
+
+	err = d.Set("license", licenseList)
+	if err != nil {
+		return fmt.Errorf("Error while setting licenses: %v", err)
+	}
+
+	d.SetId(newUUID())
+
+	return nil
+}
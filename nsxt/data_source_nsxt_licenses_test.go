@@ -0,0 +1,32 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtLicenses_basic(t *testing.T) {
+	testResourceName := "data.nsxt_licenses.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "nsxt_licenses" "test" {
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "license.#"),
+					resource.TestCheckResourceAttrSet(testResourceName, "license.0.license_key"),
+					resource.TestCheckResourceAttrSet(testResourceName, "license.0.edition"),
+				),
+			},
+		},
+	})
+}
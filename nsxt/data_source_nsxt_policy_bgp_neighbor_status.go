@@ -0,0 +1,181 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/bgp/neighbors"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyBgpNeighborStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyBgpNeighborStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"bgp_path": getPolicyPathSchema(true, false, "Policy path to the BGP config of Tier-0 gateway"),
+			"neighbor_address": {
+				Type:         schema.TypeString,
+				Description:  "Filter status by BGP neighbor address",
+				Optional:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"edge_node_path": getPolicyPathSchema(false, false, "Filter status by policy path of edge node"),
+			"neighbor": {
+				Type:        schema.TypeList,
+				Description: "BGP neighbor status per edge node",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"neighbor_address": {
+							Type:        schema.TypeString,
+							Description: "BGP neighbor address",
+							Computed:    true,
+						},
+						"edge_node_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of edge node",
+							Computed:    true,
+						},
+						"source_address": {
+							Type:        schema.TypeString,
+							Description: "Source address of BGP session",
+							Computed:    true,
+						},
+						"remote_as_number": {
+							Type:        schema.TypeString,
+							Description: "AS number of BGP neighbor",
+							Computed:    true,
+						},
+						"neighbor_router_id": {
+							Type:        schema.TypeString,
+							Description: "Router ID of BGP neighbor",
+							Computed:    true,
+						},
+						"connection_state": {
+							Type:        schema.TypeString,
+							Description: "BGP session state, such as ESTABLISHED or IDLE",
+							Computed:    true,
+						},
+						"time_since_established": {
+							Type:        schema.TypeInt,
+							Description: "Time in milliseconds since BGP session was established",
+							Computed:    true,
+						},
+						"established_connection_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of times BGP session was established",
+							Computed:    true,
+						},
+						"connection_drop_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of times BGP session was dropped",
+							Computed:    true,
+						},
+						"total_in_prefix_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of prefixes received from BGP neighbor",
+							Computed:    true,
+						},
+						"total_out_prefix_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of prefixes advertised to BGP neighbor",
+							Computed:    true,
+						},
+						"messages_received": {
+							Type:        schema.TypeInt,
+							Description: "Number of messages received from BGP neighbor",
+							Computed:    true,
+						},
+						"messages_sent": {
+							Type:        schema.TypeInt,
+							Description: "Number of messages sent to BGP neighbor",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listPolicyBgpNeighborStatus(client neighbors.StatusClient, t0ID string, serviceID string, edgePath *string) ([]model.PolicyBgpNeighborStatus, error) {
+	var results []model.PolicyBgpNeighborStatus
+	var cursor *string
+	total := 0
+
+	for {
+		statusList, err := client.List(t0ID, serviceID, cursor, edgePath, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, statusList.Results...)
+		if total == 0 && statusList.ResultCount != nil {
+			// first response
+			total = int(*statusList.ResultCount)
+		}
+
+		cursor = statusList.Cursor
+		if len(results) >= total || cursor == nil {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyBgpNeighborStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	bgpPath := d.Get("bgp_path").(string)
+	t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(bgpPath)
+	if t0ID == "" || serviceID == "" {
+		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
+	}
+
+	var edgePath *string
+	if value := d.Get("edge_node_path").(string); value != "" {
+		edgePath = &value
+	}
+	neighborAddress := d.Get("neighbor_address").(string)
+
+	client := neighbors.NewStatusClient(getPolicyConnector(m))
+	statusList, err := listPolicyBgpNeighborStatus(client, t0ID, serviceID, edgePath)
+	if err != nil {
+		return handleDataSourceReadError(d, "BGP Neighbor Status", t0ID, err)
+	}
+
+	var neighborList []map[string]interface{}
+	for _, status := range statusList {
+		if neighborAddress != "" && (status.NeighborAddress == nil || *status.NeighborAddress != neighborAddress) {
+			continue
+		}
+
+		elem := make(map[string]interface{})
+		elem["neighbor_address"] = status.NeighborAddress
+		elem["edge_node_path"] = status.EdgePath
+		elem["source_address"] = status.SourceAddress
+		elem["remote_as_number"] = status.RemoteAsNumber
+		elem["neighbor_router_id"] = status.NeighborRouterId
+		elem["connection_state"] = status.ConnectionState
+		elem["time_since_established"] = status.TimeSinceEstablished
+		elem["established_connection_count"] = status.EstablishedConnectionCount
+		elem["connection_drop_count"] = status.ConnectionDropCount
+		elem["total_in_prefix_count"] = status.TotalInPrefixCount
+		elem["total_out_prefix_count"] = status.TotalOutPrefixCount
+		elem["messages_received"] = status.MessagesReceived
+		elem["messages_sent"] = status.MessagesSent
+		neighborList = append(neighborList, elem)
+	}
+
+	err = d.Set("neighbor", neighborList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,54 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyBgpNeighborStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_bgp_neighbor_status.test"
+	subnet := "1.1.12.2/24"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "nsxt_policy_bgp_neighbor_status" "test" {
+  bgp_path = "/infra/tier-0s/test"
+}`,
+				ExpectError: regexp.MustCompile(`Invalid bgp_path`),
+			},
+			{
+				// Neighbor address is not reachable, hence the session is
+				// expected to be reported but not established
+				Config: testAccNsxtPolicyBgpNeighborStatusReadTemplate(subnet),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "neighbor_address", accTestPolicyBgpNeighborConfigCreateAttributes["neighbor_address"]),
+					resource.TestMatchResourceAttr(testResourceName, "neighbor.#", testAccNonZeroNumber),
+					resource.TestCheckResourceAttr(testResourceName, "neighbor.0.neighbor_address", accTestPolicyBgpNeighborConfigCreateAttributes["neighbor_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "neighbor.0.remote_as_number", accTestPolicyBgpNeighborConfigCreateAttributes["remote_as_num"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "neighbor.0.edge_node_path"),
+					resource.TestMatchResourceAttr(testResourceName, "neighbor.0.connection_state", regexp.MustCompile(`^(IDLE|CONNECT|ACTIVE|OPEN_SENT|OPEN_CONFIRM)$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyBgpNeighborStatusReadTemplate(subnet string) string {
+	return testAccNsxtPolicyBgpNeighborTemplate(true, subnet) + `
+data "nsxt_policy_bgp_neighbor_status" "test" {
+  bgp_path         = nsxt_policy_bgp_neighbor.test.bgp_path
+  neighbor_address = nsxt_policy_bgp_neighbor.test.neighbor_address
+}`
+}
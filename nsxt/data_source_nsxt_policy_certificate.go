@@ -4,12 +4,15 @@
 package nsxt
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicyCertificate() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyCertificateRead,
+		ReadContext: dataSourceNsxtPolicyCertificateRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -20,13 +23,21 @@ func dataSourceNsxtPolicyCertificate() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyCertificateRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
+	isGlobalManager := isPolicyGlobalManager(m)
+
+	_, err := policyDataSourceResourceRead(d, connector, isGlobalManager, "TlsCertificate", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	_, err := policyDataSourceResourceRead(d, connector, isPolicyGlobalManager(m), "TlsCertificate", nil)
+	// Warn about certificates that are about to expire, so that
+	// configurations referencing them can be fixed in time
+	obj, err := getPolicyCertificateByID(d.Id(), connector, isGlobalManager)
 	if err != nil {
-		return err
+		return diag.FromErr(handleDataSourceReadError(d, "Certificate", d.Id(), err))
 	}
 
-	return nil
+	return getPolicyCertificateExpiryWarnings(d.Id(), obj)
 }
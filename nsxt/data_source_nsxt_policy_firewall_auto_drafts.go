@@ -0,0 +1,119 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyFirewallAutoDrafts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyFirewallAutoDraftsRead,
+
+		Schema: map[string]*schema.Schema{
+			"items": {
+				Type:        schema.TypeList,
+				Description: "Auto drafts, ordered from the most recent one",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the draft",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the draft",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description of the draft",
+							Computed:    true,
+						},
+						"path": getPathSchema(),
+						"create_time": {
+							Type:        schema.TypeInt,
+							Description: "Creation time of the draft in epoch milliseconds",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listPolicyFirewallAutoDrafts(connector *client.RestConnector) ([]model.PolicyDraft, error) {
+	client := infra.NewDraftsClient(connector)
+
+	var results []model.PolicyDraft
+	var cursor *string
+	autoDrafts := true
+	total := 0
+
+	for {
+		drafts, err := client.List(&autoDrafts, cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, drafts.Results...)
+		if total == 0 && drafts.ResultCount != nil {
+			// first response
+			total = int(*drafts.ResultCount)
+		}
+
+		cursor = drafts.Cursor
+		if len(results) >= total {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyFirewallAutoDraftsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	drafts, err := listPolicyFirewallAutoDrafts(getPolicyConnector(m))
+	if err != nil {
+		return handleListError("Firewall Auto Drafts", err)
+	}
+
+	var autoDrafts []model.PolicyDraft
+	for _, draft := range drafts {
+		// Filter on client side as well, in case auto_drafts flag
+		// is ignored by the platform
+		if draft.IsAutoDraft != nil && *draft.IsAutoDraft {
+			autoDrafts = append(autoDrafts, draft)
+		}
+	}
+
+	sort.SliceStable(autoDrafts, func(i, j int) bool {
+		if autoDrafts[i].CreateTime == nil || autoDrafts[j].CreateTime == nil {
+			return autoDrafts[j].CreateTime == nil
+		}
+		return *autoDrafts[i].CreateTime > *autoDrafts[j].CreateTime
+	})
+
+	var items []map[string]interface{}
+	for _, draft := range autoDrafts {
+		item := make(map[string]interface{})
+		item["id"] = draft.Id
+		item["display_name"] = draft.DisplayName
+		item["description"] = draft.Description
+		item["path"] = draft.Path
+		item["create_time"] = draft.CreateTime
+		items = append(items, item)
+	}
+
+	d.SetId(newUUID())
+	return d.Set("items", items)
+}
@@ -0,0 +1,36 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyFirewallAutoDrafts_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_firewall_auto_drafts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccNSXVersion(t, "3.0.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallAutoDraftsReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "items.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallAutoDraftsReadTemplate() string {
+	return `
+data "nsxt_policy_firewall_auto_drafts" "test" {}`
+}
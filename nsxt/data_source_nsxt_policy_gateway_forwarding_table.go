@@ -0,0 +1,54 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyGatewayForwardingTable() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNsxtPolicyGatewayForwardingTableRead,
+		Schema: getPolicyGatewayRouteTableSchema("Policy path of Tier-0 or Tier-1 gateway"),
+	}
+}
+
+func dataSourceNsxtPolicyGatewayForwardingTableRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if gwID == "" {
+		return fmt.Errorf("Invalid gateway path %s", gwPath)
+	}
+
+	var listFunc policyGatewayRouteTableListFunc
+	if isT0 {
+		client := tier_0s.NewForwardingTableClient(connector)
+		listFunc = func(cursor *string, edgePath *string, networkPrefix *string, routeSource *string) (model.RoutingTableListResult, error) {
+			return client.List(gwID, cursor, nil, edgePath, nil, nil, networkPrefix, nil, routeSource, nil, nil)
+		}
+	} else {
+		client := tier_1s.NewForwardingTableClient(connector)
+		listFunc = func(cursor *string, edgePath *string, networkPrefix *string, routeSource *string) (model.RoutingTableListResult, error) {
+			return client.List(gwID, cursor, nil, edgePath, nil, nil, networkPrefix, nil, routeSource, nil, nil)
+		}
+	}
+
+	err := setPolicyGatewayRouteTableInSchema(d, listFunc)
+	if err != nil {
+		return handleDataSourceReadError(d, "Gateway Forwarding Table", gwID, err)
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
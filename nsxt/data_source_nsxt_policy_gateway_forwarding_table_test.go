@@ -0,0 +1,68 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyGatewayForwardingTable_tier0(t *testing.T) {
+	testResourceName := "data.nsxt_policy_gateway_forwarding_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+					testAccNsxtPolicyTier0WithEdgeClusterTemplate("test", false) +
+					testAccNsxtPolicyGatewayForwardingTableReadTemplate("nsxt_policy_tier0_gateway"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(testResourceName, "edge_node.#", testAccNonZeroNumber),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_node.0.edge_node_id"),
+					resource.TestMatchResourceAttr(testResourceName, "edge_node.0.route.#", testAccNonZeroNumber),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_node.0.route.0.network"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceNsxtPolicyGatewayForwardingTable_tier1(t *testing.T) {
+	testResourceName := "data.nsxt_policy_gateway_forwarding_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+					testAccNsxtPolicyTier1WithEdgeClusterTemplate("test", false) +
+					testAccNsxtPolicyGatewayForwardingTableReadTemplate("nsxt_policy_tier1_gateway"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(testResourceName, "edge_node.#", testAccNonZeroNumber),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_node.0.edge_node_id"),
+					resource.TestMatchResourceAttr(testResourceName, "edge_node.0.route.#", testAccNonZeroNumber),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_node.0.route.0.network"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewayForwardingTableReadTemplate(gatewayType string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_gateway_forwarding_table" "test" {
+  gateway_path = %s.test.path
+}`, gatewayType)
+}
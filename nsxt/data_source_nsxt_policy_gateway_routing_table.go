@@ -0,0 +1,179 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyGatewayRouteSourceValues = []string{"BGP", "STATIC", "CONNECTED"}
+
+// Both routing and forwarding table APIs share the same signature and result
+type policyGatewayRouteTableListFunc func(cursor *string, edgePath *string, networkPrefix *string, routeSource *string) (model.RoutingTableListResult, error)
+
+func dataSourceNsxtPolicyGatewayRoutingTable() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNsxtPolicyGatewayRoutingTableRead,
+		Schema: getPolicyGatewayRouteTableSchema("Policy path of Tier-0 gateway"),
+	}
+}
+
+func getPolicyGatewayRouteTableSchema(gatewayPathDescription string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"gateway_path": getPolicyPathSchema(true, false, gatewayPathDescription),
+		"route_source": {
+			Type:         schema.TypeString,
+			Description:  "Filter routes based on the source from which route is learned",
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(policyGatewayRouteSourceValues, false),
+		},
+		"network_prefix": {
+			Type:         schema.TypeString,
+			Description:  "Filter routes by network CIDR",
+			Optional:     true,
+			ValidateFunc: validateCidr(),
+		},
+		"edge_node_path": getPolicyPathSchema(false, false, "Filter routes by policy path of edge node"),
+		"edge_node": {
+			Type:        schema.TypeList,
+			Description: "Route entries per edge node",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"edge_node_id": {
+						Type:        schema.TypeString,
+						Description: "Transport node ID of the edge node",
+						Computed:    true,
+					},
+					"route": {
+						Type:        schema.TypeList,
+						Description: "Route entries on the edge node",
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"network": {
+									Type:        schema.TypeString,
+									Description: "Network CIDR",
+									Computed:    true,
+								},
+								"next_hop": {
+									Type:        schema.TypeString,
+									Description: "Next hop address",
+									Computed:    true,
+								},
+								"route_type": {
+									Type:        schema.TypeString,
+									Description: "Route type, such as t0c, t0s or b",
+									Computed:    true,
+								},
+								"admin_distance": {
+									Type:        schema.TypeInt,
+									Description: "Admin distance",
+									Computed:    true,
+								},
+								"lr_component_id": {
+									Type:        schema.TypeString,
+									Description: "Logical router component ID",
+									Computed:    true,
+								},
+								"lr_component_type": {
+									Type:        schema.TypeString,
+									Description: "Logical router component type",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setPolicyGatewayRouteTableInSchema(d *schema.ResourceData, listFunc policyGatewayRouteTableListFunc) error {
+	var edgePath *string
+	var networkPrefix *string
+	var routeSource *string
+	if value := d.Get("edge_node_path").(string); value != "" {
+		edgePath = &value
+	}
+	if value := d.Get("network_prefix").(string); value != "" {
+		networkPrefix = &value
+	}
+	if value := d.Get("route_source").(string); value != "" {
+		routeSource = &value
+	}
+
+	var tables []model.RoutingTable
+	var cursor *string
+	total := 0
+	for {
+		result, err := listFunc(cursor, edgePath, networkPrefix, routeSource)
+		if err != nil {
+			return err
+		}
+		tables = append(tables, result.Results...)
+		if total == 0 && result.ResultCount != nil {
+			// first response
+			total = int(*result.ResultCount)
+		}
+
+		cursor = result.Cursor
+		if len(tables) >= total || cursor == nil {
+			break
+		}
+	}
+
+	var edgeList []map[string]interface{}
+	for _, table := range tables {
+		var routeList []map[string]interface{}
+		for _, entry := range table.RouteEntries {
+			route := make(map[string]interface{})
+			route["network"] = entry.Network
+			route["next_hop"] = entry.NextHop
+			route["route_type"] = entry.RouteType
+			route["admin_distance"] = entry.AdminDistance
+			route["lr_component_id"] = entry.LrComponentId
+			route["lr_component_type"] = entry.LrComponentType
+			routeList = append(routeList, route)
+		}
+
+		elem := make(map[string]interface{})
+		elem["edge_node_id"] = table.EdgeNode
+		elem["route"] = routeList
+		edgeList = append(edgeList, elem)
+	}
+
+	return d.Set("edge_node", edgeList)
+}
+
+func dataSourceNsxtPolicyGatewayRoutingTableRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if !isT0 || gwID == "" {
+		return fmt.Errorf("Routing table is only available for Tier-0 gateway, got %s", gwPath)
+	}
+
+	client := tier_0s.NewRoutingTableClient(getPolicyConnector(m))
+	listFunc := func(cursor *string, edgePath *string, networkPrefix *string, routeSource *string) (model.RoutingTableListResult, error) {
+		return client.List(gwID, cursor, nil, edgePath, nil, nil, networkPrefix, nil, routeSource, nil, nil)
+	}
+
+	err := setPolicyGatewayRouteTableInSchema(d, listFunc)
+	if err != nil {
+		return handleDataSourceReadError(d, "Gateway Routing Table", gwID, err)
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
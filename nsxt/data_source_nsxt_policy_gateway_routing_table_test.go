@@ -0,0 +1,64 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyGatewayRoutingTable_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_gateway_routing_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayRoutingTableReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "route_source", "CONNECTED"),
+					resource.TestMatchResourceAttr(testResourceName, "edge_node.#", testAccNonZeroNumber),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_node.0.edge_node_id"),
+					resource.TestMatchResourceAttr(testResourceName, "edge_node.0.route.#", testAccNonZeroNumber),
+					resource.TestCheckResourceAttr(testResourceName, "edge_node.0.route.0.route_type", "t0c"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceNsxtPolicyGatewayRoutingTable_tier1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+					testAccNsxtPolicyTier1WithEdgeClusterTemplate("test", false) + `
+data "nsxt_policy_gateway_routing_table" "test" {
+  gateway_path = nsxt_policy_tier1_gateway.test.path
+}`,
+				ExpectError: regexp.MustCompile(`only available for Tier-0 gateway`),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewayRoutingTableReadTemplate() string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier0WithEdgeClusterTemplate("test", false) + `
+data "nsxt_policy_gateway_routing_table" "test" {
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+  route_source = "CONNECTED"
+}`
+}
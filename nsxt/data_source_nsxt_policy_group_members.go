@@ -0,0 +1,297 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/groups/members"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGroupMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_path": getPolicyPathSchema(true, false, "Policy path of the group"),
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Description: "Effective IP addresses of the group",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"mac_addresses": {
+				Type:        schema.TypeList,
+				Description: "MAC addresses of effective VIF members of the group",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"virtual_machine": {
+				Type:        schema.TypeList,
+				Description: "Effective virtual machine members of the group",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the virtual machine",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the virtual machine",
+							Computed:    true,
+						},
+						"power_state": {
+							Type:        schema.TypeString,
+							Description: "Power state of the virtual machine",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"vif": {
+				Type:        schema.TypeList,
+				Description: "Effective VIF members of the group",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "External ID of the VIF",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the VIF",
+							Computed:    true,
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address of the VIF",
+							Computed:    true,
+						},
+						"ip_addresses": {
+							Type:        schema.TypeList,
+							Description: "IP addresses of the VIF",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"owner_vm_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the virtual machine this VIF belongs to",
+							Computed:    true,
+						},
+						"lport_attachment_id": {
+							Type:        schema.TypeString,
+							Description: "Attachment ID of the port this VIF is connected to",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"segment_port": getPolicyGroupMemberDetailsSchema("Effective segment port members of the group"),
+			"segment":      getPolicyGroupMemberDetailsSchema("Effective segment members of the group"),
+		},
+	}
+}
+
+func getPolicyGroupMemberDetailsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Description: "ID of the member",
+					Computed:    true,
+				},
+				"display_name": {
+					Type:        schema.TypeString,
+					Description: "Display name of the member",
+					Computed:    true,
+				},
+				"path": {
+					Type:        schema.TypeString,
+					Description: "Policy path of the member",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func listPolicyGroupMemberDetails(domain string, groupID string, listFunc func(string, string, *string) (model.PolicyGroupMembersListResult, error)) ([]map[string]interface{}, error) {
+	var memberList []map[string]interface{}
+	lister := func(info *paginationInfo) error {
+		result, err := listFunc(domain, groupID, getPaginationCursor(info))
+		if err != nil {
+			return err
+		}
+		setPaginationInfo(info, len(result.Results), result.ResultCount, result.Cursor)
+
+		for _, member := range result.Results {
+			elem := make(map[string]interface{})
+			elem["id"] = member.Id
+			elem["display_name"] = member.DisplayName
+			elem["path"] = member.Path
+			memberList = append(memberList, elem)
+		}
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	return memberList, err
+}
+
+func setPolicyGroupIPMembersInSchema(d *schema.ResourceData, connector *client.RestConnector, domain string, groupID string) error {
+	client := members.NewIpAddressesClient(connector)
+	var ipList []string
+	lister := func(info *paginationInfo) error {
+		result, err := client.List(domain, groupID, getPaginationCursor(info), nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		setPaginationInfo(info, len(result.Results), result.ResultCount, result.Cursor)
+		ipList = append(ipList, result.Results...)
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("ip_addresses", ipList)
+}
+
+func setPolicyGroupVMMembersInSchema(d *schema.ResourceData, connector *client.RestConnector, domain string, groupID string) error {
+	client := members.NewVirtualMachinesClient(connector)
+	var vmList []map[string]interface{}
+	lister := func(info *paginationInfo) error {
+		result, err := client.List(domain, groupID, getPaginationCursor(info), nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		setPaginationInfo(info, len(result.Results), result.ResultCount, result.Cursor)
+
+		for _, vm := range result.Results {
+			elem := make(map[string]interface{})
+			elem["id"] = vm.Id
+			elem["display_name"] = vm.DisplayName
+			elem["power_state"] = vm.PowerState
+			vmList = append(vmList, elem)
+		}
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("virtual_machine", vmList)
+}
+
+func setPolicyGroupVifMembersInSchema(d *schema.ResourceData, connector *client.RestConnector, domain string, groupID string) error {
+	client := members.NewVifsClient(connector)
+	var vifList []map[string]interface{}
+	var macList []string
+	lister := func(info *paginationInfo) error {
+		result, err := client.List(domain, groupID, getPaginationCursor(info), nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		setPaginationInfo(info, len(result.Results), result.ResultCount, result.Cursor)
+
+		for _, vif := range result.Results {
+			var ipList []string
+			for _, ipInfo := range vif.IpAddressInfo {
+				ipList = append(ipList, ipInfo.IpAddresses...)
+			}
+			elem := make(map[string]interface{})
+			elem["id"] = vif.ExternalId
+			elem["display_name"] = vif.DisplayName
+			elem["mac_address"] = vif.MacAddress
+			elem["ip_addresses"] = ipList
+			elem["owner_vm_id"] = vif.OwnerVmId
+			elem["lport_attachment_id"] = vif.LportAttachmentId
+			vifList = append(vifList, elem)
+
+			if vif.MacAddress != nil && !stringInList(*vif.MacAddress, macList) {
+				macList = append(macList, *vif.MacAddress)
+			}
+		}
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	if err != nil {
+		return err
+	}
+
+	d.Set("mac_addresses", macList)
+	return d.Set("vif", vifList)
+}
+
+func dataSourceNsxtPolicyGroupMembersRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	groupPath := d.Get("group_path").(string)
+	domain := getDomainFromResourcePath(groupPath)
+	groupID := getPolicyIDFromPath(groupPath)
+	if domain == "" || groupID == "" {
+		return fmt.Errorf("Invalid group path %s", groupPath)
+	}
+
+	err := setPolicyGroupIPMembersInSchema(d, connector, domain, groupID)
+	if err != nil {
+		return handleDataSourceReadError(d, "Group IP Members", groupID, err)
+	}
+
+	err = setPolicyGroupVMMembersInSchema(d, connector, domain, groupID)
+	if err != nil {
+		return handleDataSourceReadError(d, "Group VM Members", groupID, err)
+	}
+
+	err = setPolicyGroupVifMembersInSchema(d, connector, domain, groupID)
+	if err != nil {
+		return handleDataSourceReadError(d, "Group VIF Members", groupID, err)
+	}
+
+	portsClient := members.NewSegmentPortsClient(connector)
+	portList, err := listPolicyGroupMemberDetails(domain, groupID, func(domain string, groupID string, cursor *string) (model.PolicyGroupMembersListResult, error) {
+		return portsClient.List(domain, groupID, cursor, nil, nil, nil, nil, nil, nil)
+	})
+	if err != nil {
+		return handleDataSourceReadError(d, "Group Segment Port Members", groupID, err)
+	}
+	d.Set("segment_port", portList)
+
+	segmentsClient := members.NewSegmentsClient(connector)
+	segmentList, err := listPolicyGroupMemberDetails(domain, groupID, func(domain string, groupID string, cursor *string) (model.PolicyGroupMembersListResult, error) {
+		return segmentsClient.List(domain, groupID, cursor, nil, nil, nil, nil, nil, nil)
+	})
+	if err != nil {
+		return handleDataSourceReadError(d, "Group Segment Members", groupID, err)
+	}
+	d.Set("segment", segmentList)
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,57 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceNsxtPolicyGroupMembers_ipAddresses(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_group_members.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGroupCheckDestroy(state, name, defaultDomain)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGroupMembersReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "virtual_machine.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "vif.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "segment_port.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "segment.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGroupMembersReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+
+  criteria {
+    ipaddress_expression {
+      ip_addresses = ["111.1.1.1", "222.2.2.2"]
+    }
+  }
+}
+
+data "nsxt_policy_group_members" "test" {
+  group_path = nsxt_policy_group.test.path
+}`, name)
+}
@@ -0,0 +1,153 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services/lb_pools"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLbPoolMemberStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyLbPoolMemberStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"lb_service_path": getPolicyPathSchema(true, false, "Policy path of the load balancer service"),
+			"pool_path":       getPolicyPathSchema(true, false, "Policy path of the pool"),
+			"ip_address": {
+				Type:         schema.TypeString,
+				Description:  "Filter members by IP address",
+				Optional:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"pool_status": {
+				Type:        schema.TypeString,
+				Description: "Status of the pool",
+				Computed:    true,
+			},
+			"member": {
+				Type:        schema.TypeList,
+				Description: "Status of pool members",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:        schema.TypeString,
+							Description: "IP address of the member",
+							Computed:    true,
+						},
+						"port": {
+							Type:        schema.TypeString,
+							Description: "Port of the member",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Status of the member",
+							Computed:    true,
+						},
+						"failure_cause": {
+							Type:        schema.TypeString,
+							Description: "Cause of health check failure",
+							Computed:    true,
+						},
+						"last_check_time": {
+							Type:        schema.TypeInt,
+							Description: "Timestamp of last health check, in epoch milliseconds",
+							Computed:    true,
+						},
+						"last_state_change_time": {
+							Type:        schema.TypeInt,
+							Description: "Timestamp of last status change, in epoch milliseconds",
+							Computed:    true,
+						},
+						"statistics": getPolicyLbStatisticsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyLbPoolMemberKey(ipAddress *string, port *string) string {
+	key := ""
+	if ipAddress != nil {
+		key = *ipAddress
+	}
+	if port != nil {
+		key += ":" + *port
+	}
+	return key
+}
+
+func dataSourceNsxtPolicyLbPoolMemberStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	serviceID := getPolicyIDFromPath(d.Get("lb_service_path").(string))
+	poolID := getPolicyIDFromPath(d.Get("pool_path").(string))
+	ipAddress := d.Get("ip_address").(string)
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+
+	statsClient := lb_pools.NewStatisticsClient(connector)
+	aggregateStats, err := statsClient.Get(serviceID, poolID, &enforcementPointPath, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LB Pool Statistics", poolID, err)
+	}
+
+	obj, err := convertPolicyLbAggregateResult(aggregateStats.Results, model.LBPoolStatisticsBindingType())
+	if err != nil {
+		return err
+	}
+	memberStats := make(map[string]*model.LBStatisticsCounter)
+	if obj != nil {
+		for _, member := range obj.(model.LBPoolStatistics).Members {
+			memberStats[getPolicyLbPoolMemberKey(member.IpAddress, member.Port)] = member.Statistics
+		}
+	}
+
+	client := lb_pools.NewDetailedStatusClient(connector)
+	aggregateStatus, err := client.Get(serviceID, poolID, &enforcementPointPath, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LB Pool Status", poolID, err)
+	}
+
+	obj, err = convertPolicyLbAggregateResult(aggregateStatus.Results, model.LBPoolStatusBindingType())
+	if err != nil {
+		return err
+	}
+
+	var memberList []map[string]interface{}
+	if obj != nil {
+		status := obj.(model.LBPoolStatus)
+		d.Set("pool_status", status.Status)
+
+		for _, member := range status.Members {
+			if ipAddress != "" && (member.IpAddress == nil || *member.IpAddress != ipAddress) {
+				continue
+			}
+
+			elem := make(map[string]interface{})
+			elem["ip_address"] = member.IpAddress
+			elem["port"] = member.Port
+			elem["status"] = member.Status
+			elem["failure_cause"] = member.FailureCause
+			elem["last_check_time"] = member.LastCheckTime
+			elem["last_state_change_time"] = member.LastStateChangeTime
+			elem["statistics"] = getPolicyLbStatistics(memberStats[getPolicyLbPoolMemberKey(member.IpAddress, member.Port)])
+			memberList = append(memberList, elem)
+		}
+	}
+
+	err = d.Set("member", memberList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
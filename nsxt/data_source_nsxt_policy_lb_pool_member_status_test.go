@@ -0,0 +1,40 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLbPoolMemberStatus_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_lb_pool_member_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLbStatusDepsTemplate(name) + `
+data "nsxt_policy_lb_pool_member_status" "test" {
+  lb_service_path = nsxt_policy_lb_service.test.path
+  pool_path       = nsxt_policy_lb_pool.test.path
+  ip_address      = "5.5.5.5"
+  depends_on      = [nsxt_policy_lb_virtual_server.test]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "member.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "member.0.ip_address", "5.5.5.5"),
+					resource.TestCheckResourceAttr(testResourceName, "member.0.port", "80"),
+					resource.TestCheckResourceAttrSet(testResourceName, "member.0.status"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,260 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLbServiceStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyLbServiceStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"lb_service_path": getPolicyPathSchema(true, false, "Policy path of the load balancer service"),
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the load balancer service",
+				Computed:    true,
+			},
+			"error_message": {
+				Type:        schema.TypeString,
+				Description: "Error message, if available",
+				Computed:    true,
+			},
+			"cpu_usage": {
+				Type:        schema.TypeInt,
+				Description: "CPU usage of the load balancer service, in percent",
+				Computed:    true,
+			},
+			"memory_usage": {
+				Type:        schema.TypeInt,
+				Description: "Memory usage of the load balancer service, in percent",
+				Computed:    true,
+			},
+			"active_transport_nodes": {
+				Type:        schema.TypeList,
+				Description: "IDs of transport nodes where load balancer service is active",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"standby_transport_nodes": {
+				Type:        schema.TypeList,
+				Description: "IDs of transport nodes where load balancer service is standby",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"virtual_server": {
+				Type:        schema.TypeList,
+				Description: "Status of virtual servers attached to the service",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the virtual server",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Status of the virtual server",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"pool": {
+				Type:        schema.TypeList,
+				Description: "Status of pools attached to the service",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the pool",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Status of the pool",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"l4_current_sessions": {
+				Type:        schema.TypeInt,
+				Description: "Number of current L4 sessions",
+				Computed:    true,
+			},
+			"l7_current_sessions": {
+				Type:        schema.TypeInt,
+				Description: "Number of current L7 sessions",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Converts first enforcement point result of aggregated LB status or statistics
+func convertPolicyLbAggregateResult(results []*data.StructValue, bindingType bindings.BindingType) (interface{}, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	obj, errs := converter.ConvertToGolang(results[0], bindingType)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	return obj, nil
+}
+
+func getPolicyLbStatisticsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Traffic statistics",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"current_sessions": {
+					Type:        schema.TypeInt,
+					Description: "Number of current sessions",
+					Computed:    true,
+				},
+				"max_sessions": {
+					Type:        schema.TypeInt,
+					Description: "Maximum number of concurrent sessions",
+					Computed:    true,
+				},
+				"total_sessions": {
+					Type:        schema.TypeInt,
+					Description: "Total number of sessions",
+					Computed:    true,
+				},
+				"bytes_in": {
+					Type:        schema.TypeInt,
+					Description: "Number of bytes received",
+					Computed:    true,
+				},
+				"bytes_out": {
+					Type:        schema.TypeInt,
+					Description: "Number of bytes sent",
+					Computed:    true,
+				},
+				"packets_in": {
+					Type:        schema.TypeInt,
+					Description: "Number of packets received",
+					Computed:    true,
+				},
+				"packets_out": {
+					Type:        schema.TypeInt,
+					Description: "Number of packets sent",
+					Computed:    true,
+				},
+				"http_requests": {
+					Type:        schema.TypeInt,
+					Description: "Number of HTTP requests",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func getPolicyLbStatistics(counter *model.LBStatisticsCounter) []interface{} {
+	if counter == nil {
+		return nil
+	}
+
+	elem := make(map[string]interface{})
+	elem["current_sessions"] = counter.CurrentSessions
+	elem["max_sessions"] = counter.MaxSessions
+	elem["total_sessions"] = counter.TotalSessions
+	elem["bytes_in"] = counter.BytesIn
+	elem["bytes_out"] = counter.BytesOut
+	elem["packets_in"] = counter.PacketsIn
+	elem["packets_out"] = counter.PacketsOut
+	elem["http_requests"] = counter.HttpRequests
+	return []interface{}{elem}
+}
+
+func dataSourceNsxtPolicyLbServiceStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	serviceID := getPolicyIDFromPath(d.Get("lb_service_path").(string))
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+
+	client := lb_services.NewDetailedStatusClient(connector)
+	aggregateStatus, err := client.Get(serviceID, &enforcementPointPath, nil, nil, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LB Service Status", serviceID, err)
+	}
+
+	obj, err := convertPolicyLbAggregateResult(aggregateStatus.Results, model.LBServiceStatusBindingType())
+	if err != nil {
+		return err
+	}
+	if obj != nil {
+		status := obj.(model.LBServiceStatus)
+		d.Set("status", status.ServiceStatus)
+		d.Set("error_message", status.ErrorMessage)
+		d.Set("cpu_usage", status.CpuUsage)
+		d.Set("memory_usage", status.MemoryUsage)
+		d.Set("active_transport_nodes", status.ActiveTransportNodes)
+		d.Set("standby_transport_nodes", status.StandbyTransportNodes)
+
+		var vsList []map[string]interface{}
+		for _, vs := range status.VirtualServers {
+			elem := make(map[string]interface{})
+			elem["path"] = vs.VirtualServerPath
+			elem["status"] = vs.Status
+			vsList = append(vsList, elem)
+		}
+		d.Set("virtual_server", vsList)
+
+		var poolList []map[string]interface{}
+		for _, pool := range status.Pools {
+			elem := make(map[string]interface{})
+			elem["path"] = pool.PoolPath
+			elem["status"] = pool.Status
+			poolList = append(poolList, elem)
+		}
+		d.Set("pool", poolList)
+	}
+
+	statsClient := lb_services.NewStatisticsClient(connector)
+	aggregateStats, err := statsClient.Get(serviceID, &enforcementPointPath, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LB Service Statistics", serviceID, err)
+	}
+
+	obj, err = convertPolicyLbAggregateResult(aggregateStats.Results, model.LBServiceStatisticsBindingType())
+	if err != nil {
+		return err
+	}
+	if obj != nil {
+		stats := obj.(model.LBServiceStatistics)
+		if stats.Statistics != nil {
+			d.Set("l4_current_sessions", stats.Statistics.L4CurrentSessions)
+			d.Set("l7_current_sessions", stats.Statistics.L7CurrentSessions)
+		}
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
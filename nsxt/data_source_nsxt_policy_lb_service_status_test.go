@@ -0,0 +1,83 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLbServiceStatus_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_lb_service_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLbStatusDepsTemplate(name) + `
+data "nsxt_policy_lb_service_status" "test" {
+  lb_service_path = nsxt_policy_lb_service.test.path
+  depends_on      = [nsxt_policy_lb_virtual_server.test]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(testResourceName, "status", regexp.MustCompile(`^(UP|PARTIALLY_UP|PRIMARY_DOWN|DOWN|NO_STANDBY)$`)),
+					resource.TestMatchResourceAttr(testResourceName, "active_transport_nodes.#", testAccNonZeroNumber),
+					resource.TestCheckResourceAttr(testResourceName, "virtual_server.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "virtual_server.0.path", "nsxt_policy_lb_virtual_server.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "virtual_server.0.status"),
+					resource.TestCheckResourceAttr(testResourceName, "pool.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "pool.0.path", "nsxt_policy_lb_pool.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLbStatusDepsTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_edge_cluster" "test" {
+  display_name = "%[2]s"
+}
+
+data "nsxt_policy_lb_app_profile" "default_tcp" {
+  type = "TCP"
+}
+
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name      = "%[1]s"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+}
+
+resource "nsxt_policy_lb_service" "test" {
+  display_name      = "%[1]s"
+  connectivity_path = nsxt_policy_tier1_gateway.test.path
+}
+
+resource "nsxt_policy_lb_pool" "test" {
+  display_name = "%[1]s"
+
+  member {
+    display_name = "member1"
+    ip_address   = "5.5.5.5"
+    port         = "80"
+  }
+}
+
+resource "nsxt_policy_lb_virtual_server" "test" {
+  display_name             = "%[1]s"
+  application_profile_path = data.nsxt_policy_lb_app_profile.default_tcp.path
+  service_path             = nsxt_policy_lb_service.test.path
+  pool_path                = nsxt_policy_lb_pool.test.path
+  ip_address               = "1.1.1.1"
+  ports                    = ["80"]
+}`, name, getEdgeClusterName())
+}
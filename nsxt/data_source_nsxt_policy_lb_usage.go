@@ -0,0 +1,191 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLbUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyLbUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"current_virtual_server_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of configured virtual servers",
+				Computed:    true,
+			},
+			"virtual_server_capacity": {
+				Type:        schema.TypeInt,
+				Description: "Maximum number of virtual servers",
+				Computed:    true,
+			},
+			"current_pool_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of configured pools",
+				Computed:    true,
+			},
+			"pool_capacity": {
+				Type:        schema.TypeInt,
+				Description: "Maximum number of pools",
+				Computed:    true,
+			},
+			"current_pool_member_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of configured pool members",
+				Computed:    true,
+			},
+			"pool_member_capacity": {
+				Type:        schema.TypeInt,
+				Description: "Maximum number of pool members",
+				Computed:    true,
+			},
+			"edge_node": {
+				Type:        schema.TypeList,
+				Description: "Load balancer usage per edge node",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the edge node",
+							Computed:    true,
+						},
+						"edge_cluster_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the edge cluster",
+							Computed:    true,
+						},
+						"form_factor": {
+							Type:        schema.TypeString,
+							Description: "Form factor of the edge node",
+							Computed:    true,
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Description: "Severity calculated from usage percentage",
+							Computed:    true,
+						},
+						"usage_percentage": {
+							Type:        schema.TypeFloat,
+							Description: "Usage percentage of the edge node",
+							Computed:    true,
+						},
+						"current_credits": {
+							Type:        schema.TypeInt,
+							Description: "Load balancer credits in use",
+							Computed:    true,
+						},
+						"credit_capacity": {
+							Type:        schema.TypeInt,
+							Description: "Load balancer credit capacity",
+							Computed:    true,
+						},
+						"current_pool_member_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of pool members configured on the edge node",
+							Computed:    true,
+						},
+						"pool_member_capacity": {
+							Type:        schema.TypeInt,
+							Description: "Maximum number of pool members on the edge node",
+							Computed:    true,
+						},
+						"remaining_small_lb_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of small load balancer services that can still be configured",
+							Computed:    true,
+						},
+						"remaining_medium_lb_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of medium load balancer services that can still be configured",
+							Computed:    true,
+						},
+						"remaining_large_lb_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of large load balancer services that can still be configured",
+							Computed:    true,
+						},
+						"remaining_xlarge_lb_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of extra large load balancer services that can still be configured",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyLbUsageRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	summary, err := infra.NewLbServiceUsageSummaryClient(connector).Get(nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LB Service Usage Summary", "", err)
+	}
+
+	d.Set("current_virtual_server_count", summary.CurrentVirtualServerCount)
+	d.Set("virtual_server_capacity", summary.VirtualServerCapacity)
+	d.Set("current_pool_count", summary.CurrentPoolCount)
+	d.Set("pool_capacity", summary.PoolCapacity)
+	d.Set("current_pool_member_count", summary.CurrentPoolMemberCount)
+	d.Set("pool_member_capacity", summary.PoolMemberCapacity)
+
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	includeUsages := true
+	nodeSummary, err := infra.NewLbNodeUsageSummaryClient(connector).Get(&enforcementPointPath, &includeUsages)
+	if err != nil {
+		return handleDataSourceReadError(d, "LB Node Usage Summary", "", err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	var nodeList []map[string]interface{}
+	for _, result := range nodeSummary.Results {
+		for _, nodeUsage := range result.NodeUsages {
+			obj, errs := converter.ConvertToGolang(nodeUsage, model.LBEdgeNodeUsageBindingType())
+			if len(errs) > 0 {
+				return errs[0]
+			}
+			usage := obj.(model.LBEdgeNodeUsage)
+			if usage.ResourceType != model.LBEdgeNodeUsage__TYPE_IDENTIFIER {
+				continue
+			}
+
+			elem := make(map[string]interface{})
+			elem["node_path"] = usage.NodePath
+			elem["edge_cluster_path"] = usage.EdgeClusterPath
+			elem["form_factor"] = usage.FormFactor
+			elem["severity"] = usage.Severity
+			elem["usage_percentage"] = usage.UsagePercentage
+			elem["current_credits"] = usage.CurrentLoadBalancerCredits
+			elem["credit_capacity"] = usage.LoadBalancerCreditCapacity
+			elem["current_pool_member_count"] = usage.CurrentPoolMemberCount
+			elem["pool_member_capacity"] = usage.PoolMemberCapacity
+			elem["remaining_small_lb_count"] = usage.RemainingSmallLoadBalancerCount
+			elem["remaining_medium_lb_count"] = usage.RemainingMediumLoadBalancerCount
+			elem["remaining_large_lb_count"] = usage.RemainingLargeLoadBalancerCount
+			elem["remaining_xlarge_lb_count"] = usage.RemainingXlargeLoadBalancerCount
+			nodeList = append(nodeList, elem)
+		}
+	}
+
+	err = d.Set("edge_node", nodeList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,32 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLbUsage_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_lb_usage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "nsxt_policy_lb_usage" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(testResourceName, "virtual_server_capacity", testAccNonZeroNumber),
+					resource.TestMatchResourceAttr(testResourceName, "pool_capacity", testAccNonZeroNumber),
+					resource.TestMatchResourceAttr(testResourceName, "pool_member_capacity", testAccNonZeroNumber),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,69 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services/lb_virtual_servers"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLbVirtualServerStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyLbVirtualServerStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"lb_service_path":     getPolicyPathSchema(true, false, "Policy path of the load balancer service"),
+			"virtual_server_path": getPolicyPathSchema(true, false, "Policy path of the virtual server"),
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the virtual server",
+				Computed:    true,
+			},
+			"statistics": getPolicyLbStatisticsSchema(),
+		},
+	}
+}
+
+func dataSourceNsxtPolicyLbVirtualServerStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	serviceID := getPolicyIDFromPath(d.Get("lb_service_path").(string))
+	vsID := getPolicyIDFromPath(d.Get("virtual_server_path").(string))
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+
+	client := lb_virtual_servers.NewDetailedStatusClient(connector)
+	aggregateStatus, err := client.Get(serviceID, vsID, &enforcementPointPath, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LB Virtual Server Status", vsID, err)
+	}
+
+	obj, err := convertPolicyLbAggregateResult(aggregateStatus.Results, model.LBVirtualServerStatusBindingType())
+	if err != nil {
+		return err
+	}
+	if obj != nil {
+		d.Set("status", obj.(model.LBVirtualServerStatus).Status)
+	}
+
+	statsClient := lb_virtual_servers.NewStatisticsClient(connector)
+	aggregateStats, err := statsClient.Get(serviceID, vsID, &enforcementPointPath, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LB Virtual Server Statistics", vsID, err)
+	}
+
+	obj, err = convertPolicyLbAggregateResult(aggregateStats.Results, model.LBVirtualServerStatisticsBindingType())
+	if err != nil {
+		return err
+	}
+	if obj != nil {
+		d.Set("statistics", getPolicyLbStatistics(obj.(model.LBVirtualServerStatistics).Statistics))
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,38 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLbVirtualServerStatus_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_lb_virtual_server_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLbStatusDepsTemplate(name) + `
+data "nsxt_policy_lb_virtual_server_status" "test" {
+  lb_service_path     = nsxt_policy_lb_service.test.path
+  virtual_server_path = nsxt_policy_lb_virtual_server.test.path
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(testResourceName, "status", regexp.MustCompile(`^(UP|PARTIALLY_UP|PRIMARY_DOWN|DOWN)$`)),
+					resource.TestCheckResourceAttr(testResourceName, "statistics.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "statistics.0.current_sessions", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,148 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyObjectAssociationKeys = []string{"object_path", "ip_address", "vm_external_id", "vif_external_id"}
+
+func dataSourceNsxtPolicyObjectAssociations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyObjectAssociationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"object_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the object, such as segment or segment port",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+				ExactlyOneOf: policyObjectAssociationKeys,
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Description:  "IP address",
+				Optional:     true,
+				ValidateFunc: validateSingleIP(),
+				ExactlyOneOf: policyObjectAssociationKeys,
+			},
+			"vm_external_id": {
+				Type:         schema.TypeString,
+				Description:  "External ID of virtual machine",
+				Optional:     true,
+				ExactlyOneOf: policyObjectAssociationKeys,
+			},
+			"vif_external_id": {
+				Type:         schema.TypeString,
+				Description:  "External ID of virtual network interface",
+				Optional:     true,
+				ExactlyOneOf: policyObjectAssociationKeys,
+			},
+			"group": {
+				Type:        schema.TypeList,
+				Description: "Groups the object is a member of",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the group",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the group",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the group",
+							Computed:    true,
+						},
+						"is_valid": {
+							Type:        schema.TypeBool,
+							Description: "Whether the group is valid",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type policyObjectAssociationsListFunc func(cursor *string) (model.PolicyResourceReferenceForEPListResult, error)
+
+func getPolicyObjectAssociationsListFunc(d *schema.ResourceData, connector *client.RestConnector) (string, policyObjectAssociationsListFunc) {
+	if objectPath := d.Get("object_path").(string); objectPath != "" {
+		client := infra.NewGroupAssociationsClient(connector)
+		return objectPath, func(cursor *string) (model.PolicyResourceReferenceForEPListResult, error) {
+			return client.List(objectPath, cursor, nil, nil, nil, nil, nil, nil)
+		}
+	}
+
+	if ipAddress := d.Get("ip_address").(string); ipAddress != "" {
+		client := infra.NewIpAddressGroupAssociationsClient(connector)
+		return ipAddress, func(cursor *string) (model.PolicyResourceReferenceForEPListResult, error) {
+			return client.List(ipAddress, cursor, nil, nil, nil, nil, nil, nil)
+		}
+	}
+
+	if vmID := d.Get("vm_external_id").(string); vmID != "" {
+		client := infra.NewVirtualMachineGroupAssociationsClient(connector)
+		return vmID, func(cursor *string) (model.PolicyResourceReferenceForEPListResult, error) {
+			return client.List(vmID, cursor, nil, nil, nil, nil, nil, nil)
+		}
+	}
+
+	vifID := d.Get("vif_external_id").(string)
+	client := infra.NewVirtualNetworkInterfaceGroupAssociationsClient(connector)
+	return vifID, func(cursor *string) (model.PolicyResourceReferenceForEPListResult, error) {
+		return client.List(vifID, cursor, nil, nil, nil, nil, nil, nil)
+	}
+}
+
+func dataSourceNsxtPolicyObjectAssociationsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	objectID, listFunc := getPolicyObjectAssociationsListFunc(d, getPolicyConnector(m))
+
+	var groupList []map[string]interface{}
+	lister := func(info *paginationInfo) error {
+		result, err := listFunc(getPaginationCursor(info))
+		if err != nil {
+			return err
+		}
+		setPaginationInfo(info, len(result.Results), result.ResultCount, result.Cursor)
+
+		for _, reference := range result.Results {
+			elem := make(map[string]interface{})
+			elem["id"] = reference.TargetId
+			elem["display_name"] = reference.TargetDisplayName
+			elem["path"] = reference.Path
+			elem["is_valid"] = reference.IsValid
+			groupList = append(groupList, elem)
+		}
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	if err != nil {
+		return handleDataSourceReadError(d, "Group Associations", objectID, err)
+	}
+
+	err = d.Set("group", groupList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,56 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceNsxtPolicyObjectAssociations_ipAddress(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_object_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGroupCheckDestroy(state, name, defaultDomain)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyObjectAssociationsReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "group.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "group.0.display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "group.0.path", "nsxt_policy_group.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyObjectAssociationsReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+
+  criteria {
+    ipaddress_expression {
+      ip_addresses = ["111.11.11.11"]
+    }
+  }
+}
+
+data "nsxt_policy_object_associations" "test" {
+  ip_address = "111.11.11.11"
+  depends_on = [nsxt_policy_group.test]
+}`, name)
+}
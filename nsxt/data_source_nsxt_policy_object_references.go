@@ -0,0 +1,107 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyObjectReferences() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyObjectReferencesRead,
+
+		Schema: map[string]*schema.Schema{
+			"object_path": getPolicyPathSchema(true, false, "Policy path of the referenced object, such as group or service"),
+			"resource_type": {
+				Type:        schema.TypeString,
+				Description: "Filter references by resource type, such as Rule or Group",
+				Optional:    true,
+			},
+			"reference": {
+				Type:        schema.TypeList,
+				Description: "Objects that reference the given object",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:        schema.TypeString,
+							Description: "Resource type of the referencing object",
+							Computed:    true,
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the referencing object",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the referencing object",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the referencing object",
+							Computed:    true,
+						},
+						"parent_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the parent of referencing object, such as security policy of a rule",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyObjectReferencesRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	objectPath := d.Get("object_path").(string)
+	var additionalQuery *string
+	if resourceType := d.Get("resource_type").(string); resourceType != "" {
+		query := fmt.Sprintf("resource_type:%s", resourceType)
+		additionalQuery = &query
+	}
+
+	results, err := listPolicyResourcesByReferencedPath(getPolicyConnector(m), objectPath, additionalQuery)
+	if err != nil {
+		return handleDataSourceReadError(d, "Object References", objectPath, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	var referenceList []map[string]interface{}
+	for _, result := range results {
+		dataValue, errors := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errors) > 0 {
+			return errors[0]
+		}
+		policyResource := dataValue.(model.PolicyResource)
+
+		elem := make(map[string]interface{})
+		elem["resource_type"] = policyResource.ResourceType
+		elem["id"] = policyResource.Id
+		elem["display_name"] = policyResource.DisplayName
+		elem["path"] = policyResource.Path
+		elem["parent_path"] = policyResource.ParentPath
+		referenceList = append(referenceList, elem)
+	}
+
+	err = d.Set("reference", referenceList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,59 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyObjectReferences_group(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_object_references.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyObjectReferencesReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "reference.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "reference.0.resource_type", "Rule"),
+					resource.TestCheckResourceAttr(testResourceName, "reference.0.display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "reference.0.parent_path", "nsxt_policy_security_policy.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyObjectReferencesReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%[1]s"
+}
+
+resource "nsxt_policy_security_policy" "test" {
+  display_name = "%[1]s"
+  category     = "Application"
+
+  rule {
+    display_name  = "%[1]s"
+    source_groups = [nsxt_policy_group.test.path]
+    action        = "ALLOW"
+  }
+}
+
+data "nsxt_policy_object_references" "test" {
+  object_path   = nsxt_policy_group.test.path
+  resource_type = "Rule"
+  depends_on    = [nsxt_policy_security_policy.test]
+}`, name)
+}
@@ -0,0 +1,161 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/ospf"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyOspfNeighborStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyOspfNeighborStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"ospf_path": getPolicyPathSchema(true, false, "Policy path to the OSPF config of Tier-0 gateway"),
+			"neighbor_address": {
+				Type:         schema.TypeString,
+				Description:  "Filter status by OSPF neighbor address",
+				Optional:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"edge_node_path": getPolicyPathSchema(false, false, "Filter status by policy path of edge node"),
+			"neighbor": {
+				Type:        schema.TypeList,
+				Description: "OSPF neighbor status per edge node",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"neighbor_address": {
+							Type:        schema.TypeString,
+							Description: "OSPF neighbor address",
+							Computed:    true,
+						},
+						"edge_node_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of edge node",
+							Computed:    true,
+						},
+						"edge_display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of edge node",
+							Computed:    true,
+						},
+						"interface_name": {
+							Type:        schema.TypeString,
+							Description: "Tier-0 interface name",
+							Computed:    true,
+						},
+						"source_address": {
+							Type:        schema.TypeString,
+							Description: "Source address of OSPF session",
+							Computed:    true,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Description: "OSPF neighbor state",
+							Computed:    true,
+						},
+						"last_state_change": {
+							Type:        schema.TypeString,
+							Description: "Time since last change in state",
+							Computed:    true,
+						},
+						"dead_time": {
+							Type:        schema.TypeString,
+							Description: "Time remaining before considering OSPF neighbor dead",
+							Computed:    true,
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Description: "Priority of OSPF neighbor",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listPolicyOspfNeighborStatus(client ospf.NeighborsClient, t0ID string, serviceID string, edgePath *string, neighborAddress *string) ([]model.OspfNeighbor, error) {
+	var results []model.OspfNeighbor
+	var cursor *string
+	total := 0
+
+	for {
+		statusList, err := client.List(t0ID, serviceID, cursor, edgePath, nil, neighborAddress, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, statusList.Results...)
+		if total == 0 && statusList.ResultCount != nil {
+			// first response
+			total = int(*statusList.ResultCount)
+		}
+
+		cursor = statusList.Cursor
+		if len(results) >= total || cursor == nil {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyOspfNeighborStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	ospfPath := d.Get("ospf_path").(string)
+	t0ID, serviceID := parseOspfConfigPath(ospfPath)
+	if t0ID == "" || serviceID == "" {
+		return fmt.Errorf("Invalid ospf_path %s", ospfPath)
+	}
+
+	var edgePath *string
+	var neighborAddress *string
+	if value := d.Get("edge_node_path").(string); value != "" {
+		edgePath = &value
+	}
+	if value := d.Get("neighbor_address").(string); value != "" {
+		neighborAddress = &value
+	}
+
+	client := ospf.NewNeighborsClient(getPolicyConnector(m))
+	edges, err := listPolicyOspfNeighborStatus(client, t0ID, serviceID, edgePath, neighborAddress)
+	if err != nil {
+		return handleDataSourceReadError(d, "OSPF Neighbor Status", t0ID, err)
+	}
+
+	// Status is reported per edge node, neighbor and interface
+	var neighborList []map[string]interface{}
+	for _, edge := range edges {
+		for _, neighbor := range edge.Neighbors {
+			for _, info := range neighbor.NeighborStatusInfo {
+				elem := make(map[string]interface{})
+				elem["neighbor_address"] = neighbor.NeighborAddress
+				elem["edge_node_path"] = edge.EdgePath
+				elem["edge_display_name"] = edge.EdgeDisplayName
+				elem["interface_name"] = info.InterfaceName
+				elem["source_address"] = info.SourceAddress
+				elem["state"] = info.State
+				elem["last_state_change"] = info.LastStateChange
+				elem["dead_time"] = info.DeadTime
+				elem["priority"] = info.Priority
+				neighborList = append(neighborList, elem)
+			}
+		}
+	}
+
+	err = d.Set("neighbor", neighborList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
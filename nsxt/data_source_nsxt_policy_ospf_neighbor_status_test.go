@@ -0,0 +1,48 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyOspfNeighborStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_ospf_neighbor_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccNSXVersion(t, "3.1.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "nsxt_policy_ospf_neighbor_status" "test" {
+  ospf_path = "/infra/tier-0s/test"
+}`,
+				ExpectError: regexp.MustCompile(`Invalid ospf_path`),
+			},
+			{
+				// OSPF neighbors are discovered rather than configured, and
+				// test gateway has no peers
+				Config: testAccNsxtPolicyOspfNeighborStatusReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "neighbor.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyOspfNeighborStatusReadTemplate() string {
+	return testAccNsxtPolicyOspfAreaTemplate(true) + `
+data "nsxt_policy_ospf_neighbor_status" "test" {
+  ospf_path = nsxt_policy_ospf_area.test.ospf_path
+}`
+}
@@ -0,0 +1,121 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/realized_state"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyRealizedAlarms() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyRealizedAlarmsRead,
+
+		Schema: map[string]*schema.Schema{
+			"source_path": getPolicyPathSchema(false, false, "Policy path of the object to retrieve alarms for"),
+			"alarm": {
+				Type:        schema.TypeList,
+				Description: "Realization alarms",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the alarm",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the alarm",
+							Computed:    true,
+						},
+						"source_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the object the alarm is raised for",
+							Computed:    true,
+						},
+						"source_site_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the site the alarm originates from",
+							Computed:    true,
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Description: "Alarm message",
+							Computed:    true,
+						},
+						"error_code": {
+							Type:        schema.TypeInt,
+							Description: "Error code of the realization failure",
+							Computed:    true,
+						},
+						"error_message": {
+							Type:        schema.TypeString,
+							Description: "Error message of the realization failure",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listPolicyRealizedAlarms(connector *client.RestConnector) ([]model.PolicyAlarmResource, error) {
+	client := realized_state.NewAlarmsClient(connector)
+	var alarms []model.PolicyAlarmResource
+	lister := func(info *paginationInfo) error {
+		result, err := client.List(getPaginationCursor(info), nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		setPaginationInfo(info, len(result.Results), result.ResultCount, result.Cursor)
+		alarms = append(alarms, result.Results...)
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	return alarms, err
+}
+
+func dataSourceNsxtPolicyRealizedAlarmsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	sourcePath := d.Get("source_path").(string)
+	alarms, err := listPolicyRealizedAlarms(getPolicyConnector(m))
+	if err != nil {
+		return handleDataSourceReadError(d, "Realized Alarms", sourcePath, err)
+	}
+
+	var alarmList []map[string]interface{}
+	for _, alarm := range alarms {
+		if sourcePath != "" && (alarm.SourceReference == nil || *alarm.SourceReference != sourcePath) {
+			continue
+		}
+
+		elem := make(map[string]interface{})
+		elem["id"] = alarm.Id
+		elem["path"] = alarm.Path
+		elem["source_path"] = alarm.SourceReference
+		elem["source_site_id"] = alarm.SourceSiteId
+		elem["message"] = alarm.Message
+		if alarm.ErrorDetails != nil {
+			elem["error_code"] = alarm.ErrorDetails.ErrorCode
+			elem["error_message"] = alarm.ErrorDetails.ErrorMessage
+		}
+		alarmList = append(alarmList, elem)
+	}
+
+	err = d.Set("alarm", alarmList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
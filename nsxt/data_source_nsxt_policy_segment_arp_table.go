@@ -0,0 +1,93 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicySegmentArpTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentArpTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"segment_path":   getPolicyPathSchema(true, false, "Policy path of the segment"),
+			"edge_node_path": getPolicyPathSchema(false, false, "Policy path of edge node to read ARP table from"),
+			"entry": {
+				Type:        schema.TypeList,
+				Description: "ARP table entries",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Description: "IP address",
+							Computed:    true,
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicySegmentArpTableRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	segmentID, err := getPolicyInfraSegmentIDFromPath(d.Get("segment_path").(string))
+	if err != nil {
+		return err
+	}
+
+	var edgePath *string
+	if value := d.Get("edge_node_path").(string); value != "" {
+		edgePath = &value
+	}
+
+	client := segments.NewArpTableClient(getPolicyConnector(m))
+	var entries []model.InterfaceArpEntry
+	var cursor *string
+	total := 0
+	for {
+		table, err := client.List(segmentID, cursor, edgePath, nil, nil, nil, nil, nil)
+		if err != nil {
+			return handleDataSourceReadError(d, "Segment ARP Table", segmentID, err)
+		}
+		entries = append(entries, table.Results...)
+		if total == 0 && table.ResultCount != nil {
+			// first response
+			total = int(*table.ResultCount)
+		}
+
+		cursor = table.Cursor
+		if len(entries) >= total || cursor == nil {
+			break
+		}
+	}
+
+	var entryList []map[string]interface{}
+	for _, entry := range entries {
+		elem := make(map[string]interface{})
+		elem["ip"] = entry.Ip
+		elem["mac_address"] = entry.MacAddress
+		entryList = append(entryList, elem)
+	}
+
+	err = d.Set("entry", entryList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
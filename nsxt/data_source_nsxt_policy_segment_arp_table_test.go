@@ -0,0 +1,33 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySegmentArpTable_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_segment_arp_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_VM_SEGMENT_ID")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyVMSegmentDataReadTemplate("nsxt_policy_segment_arp_table"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(testResourceName, "entry.#", testAccNonZeroNumber),
+					resource.TestCheckResourceAttrSet(testResourceName, "entry.0.ip"),
+					resource.TestCheckResourceAttrSet(testResourceName, "entry.0.mac_address"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,103 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicySegmentMacTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentMacTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"segment_path": getPolicyPathSchema(true, false, "Policy path of the segment"),
+			"transport_node_id": {
+				Type:        schema.TypeString,
+				Description: "ID of transport node to read MAC table from",
+				Optional:    true,
+			},
+			"entry": {
+				Type:        schema.TypeList,
+				Description: "MAC table entries",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address",
+							Computed:    true,
+						},
+						"vtep_ip": {
+							Type:        schema.TypeString,
+							Description: "IP address of the tunnel endpoint the MAC address is learned from",
+							Computed:    true,
+						},
+						"vtep_mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address of the tunnel endpoint the MAC address is learned from",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicySegmentMacTableRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	segmentID, err := getPolicyInfraSegmentIDFromPath(d.Get("segment_path").(string))
+	if err != nil {
+		return err
+	}
+
+	var transportNodeID *string
+	if value := d.Get("transport_node_id").(string); value != "" {
+		transportNodeID = &value
+	}
+
+	client := segments.NewMacTableClient(getPolicyConnector(m))
+	var entries []model.MacTableEntry
+	var cursor *string
+	total := 0
+	for {
+		table, err := client.List(segmentID, cursor, nil, nil, nil, nil, nil, nil, transportNodeID)
+		if err != nil {
+			return handleDataSourceReadError(d, "Segment MAC Table", segmentID, err)
+		}
+		entries = append(entries, table.Results...)
+		if total == 0 && table.ResultCount != nil {
+			// first response
+			total = int(*table.ResultCount)
+		}
+
+		cursor = table.Cursor
+		if len(entries) >= total || cursor == nil {
+			break
+		}
+	}
+
+	var entryList []map[string]interface{}
+	for _, entry := range entries {
+		elem := make(map[string]interface{})
+		elem["mac_address"] = entry.MacAddress
+		elem["vtep_ip"] = entry.VtepIp
+		elem["vtep_mac_address"] = entry.VtepMacAddress
+		entryList = append(entryList, elem)
+	}
+
+	err = d.Set("entry", entryList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
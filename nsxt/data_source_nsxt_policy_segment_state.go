@@ -0,0 +1,133 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments"
+)
+
+func dataSourceNsxtPolicySegmentState() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentStateRead,
+
+		Schema: map[string]*schema.Schema{
+			"segment_path":   getPolicyPathSchema(true, false, "Policy path of the segment"),
+			"edge_node_path": getPolicyPathSchema(false, false, "Policy path of edge node to read state from"),
+			"admin_state": {
+				Type:        schema.TypeString,
+				Description: "Configured admin state of the segment",
+				Computed:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "Overall configuration state of the segment",
+				Computed:    true,
+			},
+			"failure_code": {
+				Type:        schema.TypeInt,
+				Description: "Error code in case of failure",
+				Computed:    true,
+			},
+			"failure_message": {
+				Type:        schema.TypeString,
+				Description: "Error message in case of failure",
+				Computed:    true,
+			},
+			"details": {
+				Type:        schema.TypeList,
+				Description: "Configuration state per sub system, such as transport node",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sub_system_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the sub system",
+							Computed:    true,
+						},
+						"sub_system_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the sub system",
+							Computed:    true,
+						},
+						"sub_system_type": {
+							Type:        schema.TypeString,
+							Description: "Type of the sub system",
+							Computed:    true,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Description: "Configuration state on the sub system",
+							Computed:    true,
+						},
+						"failure_code": {
+							Type:        schema.TypeInt,
+							Description: "Error code in case of failure",
+							Computed:    true,
+						},
+						"failure_message": {
+							Type:        schema.TypeString,
+							Description: "Error message in case of failure",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicySegmentStateRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	segmentID, err := getPolicyInfraSegmentIDFromPath(d.Get("segment_path").(string))
+	if err != nil {
+		return err
+	}
+
+	var edgePath *string
+	if value := d.Get("edge_node_path").(string); value != "" {
+		edgePath = &value
+	}
+
+	segment, err := infra.NewSegmentsClient(connector).Get(segmentID)
+	if err != nil {
+		return handleDataSourceReadError(d, "Segment", segmentID, err)
+	}
+
+	client := segments.NewStateClient(connector)
+	state, err := client.Get(segmentID, nil, edgePath, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "Segment State", segmentID, err)
+	}
+
+	d.Set("admin_state", segment.AdminState)
+	d.Set("state", state.State)
+	d.Set("failure_code", state.FailureCode)
+	d.Set("failure_message", state.FailureMessage)
+
+	var detailList []map[string]interface{}
+	for _, detail := range state.Details {
+		elem := make(map[string]interface{})
+		elem["sub_system_id"] = detail.SubSystemId
+		elem["sub_system_name"] = detail.SubSystemName
+		elem["sub_system_type"] = detail.SubSystemType
+		elem["state"] = detail.State
+		elem["failure_code"] = detail.FailureCode
+		elem["failure_message"] = detail.FailureMessage
+		detailList = append(detailList, elem)
+	}
+
+	err = d.Set("details", detailList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,66 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySegmentState_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	tzName := getOverlayTransportZoneName()
+	testResourceName := "data.nsxt_policy_segment_state.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentStateReadTemplate(tzName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", "UP"),
+					resource.TestMatchResourceAttr(testResourceName, "state", regexp.MustCompile(`^(pending|in_progress|success)$`)),
+					resource.TestCheckResourceAttr(testResourceName, "failure_message", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceNsxtPolicySegmentState_vmSegment(t *testing.T) {
+	testResourceName := "data.nsxt_policy_segment_state.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_VM_SEGMENT_ID")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyVMSegmentDataReadTemplate("nsxt_policy_segment_state"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", "UP"),
+					resource.TestCheckResourceAttr(testResourceName, "state", "success"),
+					resource.TestMatchResourceAttr(testResourceName, "details.#", testAccNonZeroNumber),
+					resource.TestCheckResourceAttr(testResourceName, "details.0.state", "success"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentStateReadTemplate(tzName string, name string) string {
+	return testAccNsxtPolicySegmentImportTemplate(tzName, name) + `
+data "nsxt_policy_segment_state" "test" {
+  segment_path = nsxt_policy_segment.test.path
+}`
+}
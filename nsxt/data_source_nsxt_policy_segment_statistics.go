@@ -0,0 +1,122 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicySegmentStatistics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentStatisticsRead,
+
+		Schema: map[string]*schema.Schema{
+			"segment_path":   getPolicyPathSchema(true, false, "Policy path of the segment"),
+			"edge_node_path": getPolicyPathSchema(false, false, "Policy path of edge node to read statistics from"),
+			"rx_bytes":       getPolicySegmentDataCounterSchema("Received bytes"),
+			"rx_packets":     getPolicySegmentDataCounterSchema("Received packets"),
+			"tx_bytes":       getPolicySegmentDataCounterSchema("Transmitted bytes"),
+			"tx_packets":     getPolicySegmentDataCounterSchema("Transmitted packets"),
+			"macs_learned": {
+				Type:        schema.TypeInt,
+				Description: "Number of MAC addresses learned",
+				Computed:    true,
+			},
+			"mac_not_learned_packets_allowed": {
+				Type:        schema.TypeInt,
+				Description: "Number of packets with unknown source MAC address allowed",
+				Computed:    true,
+			},
+			"mac_not_learned_packets_dropped": {
+				Type:        schema.TypeInt,
+				Description: "Number of packets with unknown source MAC address dropped",
+				Computed:    true,
+			},
+			"last_update_timestamp": {
+				Type:        schema.TypeInt,
+				Description: "Timestamp when the statistics were last updated, in epoch milliseconds",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getPolicySegmentDataCounterSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: fmt.Sprintf("%s counters", description),
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"total": {
+					Type:        schema.TypeInt,
+					Description: "Total count",
+					Computed:    true,
+				},
+				"dropped": {
+					Type:        schema.TypeInt,
+					Description: "Dropped count",
+					Computed:    true,
+				},
+				"multicast_broadcast": {
+					Type:        schema.TypeInt,
+					Description: "Multicast and broadcast count",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func getPolicySegmentDataCounter(counter *model.DataCounter) []interface{} {
+	if counter == nil {
+		return nil
+	}
+
+	elem := make(map[string]interface{})
+	elem["total"] = counter.Total
+	elem["dropped"] = counter.Dropped
+	elem["multicast_broadcast"] = counter.MulticastBroadcast
+	return []interface{}{elem}
+}
+
+func dataSourceNsxtPolicySegmentStatisticsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	segmentID, err := getPolicyInfraSegmentIDFromPath(d.Get("segment_path").(string))
+	if err != nil {
+		return err
+	}
+
+	var edgePath *string
+	if value := d.Get("edge_node_path").(string); value != "" {
+		edgePath = &value
+	}
+
+	client := segments.NewStatisticsClient(getPolicyConnector(m))
+	stats, err := client.Get(segmentID, nil, edgePath, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "Segment Statistics", segmentID, err)
+	}
+
+	d.Set("rx_bytes", getPolicySegmentDataCounter(stats.RxBytes))
+	d.Set("rx_packets", getPolicySegmentDataCounter(stats.RxPackets))
+	d.Set("tx_bytes", getPolicySegmentDataCounter(stats.TxBytes))
+	d.Set("tx_packets", getPolicySegmentDataCounter(stats.TxPackets))
+	if stats.MacLearning != nil {
+		d.Set("macs_learned", stats.MacLearning.MacsLearned)
+		d.Set("mac_not_learned_packets_allowed", stats.MacLearning.MacNotLearnedPacketsAllowed)
+		d.Set("mac_not_learned_packets_dropped", stats.MacLearning.MacNotLearnedPacketsDropped)
+	}
+	d.Set("last_update_timestamp", stats.LastUpdateTimestamp)
+
+	d.SetId(newUUID())
+	return nil
+}
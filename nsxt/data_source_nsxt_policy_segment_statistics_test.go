@@ -0,0 +1,35 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySegmentStatistics_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_segment_statistics.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_VM_SEGMENT_ID")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyVMSegmentDataReadTemplate("nsxt_policy_segment_statistics"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "rx_packets.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "tx_packets.#", "1"),
+					resource.TestMatchResourceAttr(testResourceName, "rx_packets.0.total", testAccNonZeroNumber),
+					resource.TestMatchResourceAttr(testResourceName, "tx_packets.0.total", testAccNonZeroNumber),
+					resource.TestCheckResourceAttrSet(testResourceName, "last_update_timestamp"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,103 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicySegmentTepTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentTepTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"segment_path": getPolicyPathSchema(true, false, "Policy path of the segment"),
+			"transport_node_id": {
+				Type:        schema.TypeString,
+				Description: "ID of transport node to read TEP table from",
+				Optional:    true,
+			},
+			"entry": {
+				Type:        schema.TypeList,
+				Description: "TEP table entries",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tep_ip": {
+							Type:        schema.TypeString,
+							Description: "Tunnel endpoint IP address",
+							Computed:    true,
+						},
+						"tep_mac_address": {
+							Type:        schema.TypeString,
+							Description: "Tunnel endpoint MAC address",
+							Computed:    true,
+						},
+						"tep_label": {
+							Type:        schema.TypeInt,
+							Description: "Tunnel endpoint label",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicySegmentTepTableRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	segmentID, err := getPolicyInfraSegmentIDFromPath(d.Get("segment_path").(string))
+	if err != nil {
+		return err
+	}
+
+	var transportNodeID *string
+	if value := d.Get("transport_node_id").(string); value != "" {
+		transportNodeID = &value
+	}
+
+	client := segments.NewTepTableClient(getPolicyConnector(m))
+	var entries []model.PolicyTepTableEntry
+	var cursor *string
+	total := 0
+	for {
+		table, err := client.List(segmentID, cursor, nil, nil, nil, nil, nil, nil, transportNodeID)
+		if err != nil {
+			return handleDataSourceReadError(d, "Segment TEP Table", segmentID, err)
+		}
+		entries = append(entries, table.Results...)
+		if total == 0 && table.ResultCount != nil {
+			// first response
+			total = int(*table.ResultCount)
+		}
+
+		cursor = table.Cursor
+		if len(entries) >= total || cursor == nil {
+			break
+		}
+	}
+
+	var entryList []map[string]interface{}
+	for _, entry := range entries {
+		elem := make(map[string]interface{})
+		elem["tep_ip"] = entry.TepIp
+		elem["tep_mac_address"] = entry.TepMacAddress
+		elem["tep_label"] = entry.TepLabel
+		entryList = append(entryList, elem)
+	}
+
+	err = d.Set("entry", entryList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
@@ -0,0 +1,98 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+)
+
+func dataSourceNsxtPolicyTags() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyTagsRead,
+
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:        schema.TypeString,
+				Description: "Filter tags by scope, use * for starts with, ends with or contains match",
+				Optional:    true,
+			},
+			"tag": {
+				Type:        schema.TypeString,
+				Description: "Filter tags by tag value, use * for starts with, ends with or contains match",
+				Optional:    true,
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Description: "Unique tags in use",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope": {
+							Type:        schema.TypeString,
+							Description: "Tag scope",
+							Computed:    true,
+						},
+						"tag": {
+							Type:        schema.TypeString,
+							Description: "Tag value",
+							Computed:    true,
+						},
+						"tagged_objects_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of objects with this tag",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyTagsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	var scope, tag *string
+	if value := d.Get("scope").(string); value != "" {
+		scope = &value
+	}
+	if value := d.Get("tag").(string); value != "" {
+		tag = &value
+	}
+
+	client := infra.NewTagsClient(getPolicyConnector(m))
+	var tagList []map[string]interface{}
+	lister := func(info *paginationInfo) error {
+		result, err := client.List(getPaginationCursor(info), nil, nil, nil, scope, nil, nil, nil, tag)
+		if err != nil {
+			return err
+		}
+		setPaginationInfo(info, len(result.Results), result.ResultCount, result.Cursor)
+
+		for _, tagInfo := range result.Results {
+			elem := make(map[string]interface{})
+			elem["scope"] = tagInfo.Scope
+			elem["tag"] = tagInfo.Tag
+			elem["tagged_objects_count"] = tagInfo.TaggedObjectsCount
+			tagList = append(tagList, elem)
+		}
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	if err != nil {
+		return handleDataSourceReadError(d, "Tags", "", err)
+	}
+
+	err = d.Set("items", tagList)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+	return nil
+}
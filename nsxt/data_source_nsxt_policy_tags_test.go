@@ -0,0 +1,52 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyTags_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTagsReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.scope", name),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.tag", "tag1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.tagged_objects_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTagsReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%[1]s"
+
+  tag {
+    scope = "%[1]s"
+    tag   = "tag1"
+  }
+}
+
+data "nsxt_policy_tags" "test" {
+  scope      = "%[1]s"
+  depends_on = [nsxt_policy_group.test]
+}`, name)
+}
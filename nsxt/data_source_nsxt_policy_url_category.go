@@ -0,0 +1,123 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyURLCategory() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyURLCategoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":           getDataSourceIDSchema(),
+			"display_name": getDataSourceDisplayNameSchema(),
+			"description":  getDataSourceDescriptionSchema(),
+			"path":         getPathSchema(),
+			"category_id": {
+				Type:        schema.TypeInt,
+				Description: "Numeric ID of the URL category",
+				Computed:    true,
+			},
+			"category_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the URL category, to be used as value of url_category context profile attribute",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func listPolicyURLCategories(connector *client.RestConnector) ([]model.PolicyUrlCategory, error) {
+	client := infra.NewUrlCategoriesClient(connector)
+
+	var results []model.PolicyUrlCategory
+	var cursor *string
+	total := 0
+
+	for {
+		categories, err := client.List(cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, categories.Results...)
+		if total == 0 && categories.ResultCount != nil {
+			// first response
+			total = int(*categories.ResultCount)
+		}
+
+		cursor = categories.Cursor
+		if len(results) >= total {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyURLCategoryRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	objID := d.Get("id").(string)
+	objName := d.Get("display_name").(string)
+	if objID == "" && objName == "" {
+		return fmt.Errorf("Error obtaining URL Category ID or name during read")
+	}
+
+	// URL categories are read-only and do not support GET by ID
+	objList, err := listPolicyURLCategories(connector)
+	if err != nil {
+		return handleListError("URL Category", err)
+	}
+
+	var obj model.PolicyUrlCategory
+	var perfectMatch []model.PolicyUrlCategory
+	var prefixMatch []model.PolicyUrlCategory
+	for _, objInList := range objList {
+		if objID != "" {
+			if *objInList.Id == objID {
+				perfectMatch = append(perfectMatch, objInList)
+			}
+			continue
+		}
+		if strings.HasPrefix(*objInList.DisplayName, objName) {
+			prefixMatch = append(prefixMatch, objInList)
+		}
+		if *objInList.DisplayName == objName {
+			perfectMatch = append(perfectMatch, objInList)
+		}
+	}
+	if len(perfectMatch) > 0 {
+		if len(perfectMatch) > 1 {
+			return fmt.Errorf("Found multiple URL Categories with name '%s'", objName)
+		}
+		obj = perfectMatch[0]
+	} else if objID != "" {
+		return fmt.Errorf("URL Category with ID '%s' was not found", objID)
+	} else if len(prefixMatch) > 0 {
+		if len(prefixMatch) > 1 {
+			return fmt.Errorf("Found multiple URL Categories with name starting with '%s'", objName)
+		}
+		obj = prefixMatch[0]
+	} else {
+		return fmt.Errorf("URL Category with name '%s' was not found", objName)
+	}
+
+	d.SetId(*obj.Id)
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	d.Set("path", obj.Path)
+	d.Set("category_id", obj.CategoryId)
+	d.Set("category_name", obj.CategoryName)
+	return nil
+}
@@ -0,0 +1,45 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyURLCategory_basic(t *testing.T) {
+	name := os.Getenv("NSXT_TEST_URL_CATEGORY")
+	testResourceName := "data.nsxt_policy_url_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccNSXVersion(t, "3.1.0")
+			testAccEnvDefined(t, "NSXT_TEST_URL_CATEGORY")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyURLCategoryReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "category_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "category_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyURLCategoryReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_url_category" "test" {
+  display_name = "%s"
+}`, name)
+}
@@ -0,0 +1,135 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyURLReputationSeverity() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyURLReputationSeverityRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":           getDataSourceIDSchema(),
+			"display_name": getDataSourceDisplayNameSchema(),
+			"description":  getDataSourceDescriptionSchema(),
+			"path":         getPathSchema(),
+			"reputation_severity_id": {
+				Type:        schema.TypeInt,
+				Description: "Numeric ID of the URL reputation severity",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the URL reputation severity",
+				Computed:    true,
+			},
+			"min_reputation": {
+				Type:        schema.TypeInt,
+				Description: "Minimum reputation score of this severity",
+				Computed:    true,
+			},
+			"max_reputation": {
+				Type:        schema.TypeInt,
+				Description: "Maximum reputation score of this severity",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func listPolicyURLReputationSeverities(connector *client.RestConnector) ([]model.PolicyUrlReputationSeverity, error) {
+	client := infra.NewUrlReputationSeveritiesClient(connector)
+
+	var results []model.PolicyUrlReputationSeverity
+	var cursor *string
+	total := 0
+
+	for {
+		severities, err := client.List(cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, severities.Results...)
+		if total == 0 && severities.ResultCount != nil {
+			// first response
+			total = int(*severities.ResultCount)
+		}
+
+		cursor = severities.Cursor
+		if len(results) >= total {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyURLReputationSeverityRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	objID := d.Get("id").(string)
+	objName := d.Get("display_name").(string)
+	if objID == "" && objName == "" {
+		return fmt.Errorf("Error obtaining URL Reputation Severity ID or name during read")
+	}
+
+	// URL severities are read-only and do not support GET by ID
+	objList, err := listPolicyURLReputationSeverities(connector)
+	if err != nil {
+		return handleListError("URL Reputation Severity", err)
+	}
+
+	var obj model.PolicyUrlReputationSeverity
+	var perfectMatch []model.PolicyUrlReputationSeverity
+	var prefixMatch []model.PolicyUrlReputationSeverity
+	for _, objInList := range objList {
+		if objID != "" {
+			if *objInList.Id == objID {
+				perfectMatch = append(perfectMatch, objInList)
+			}
+			continue
+		}
+		if strings.HasPrefix(*objInList.DisplayName, objName) {
+			prefixMatch = append(prefixMatch, objInList)
+		}
+		if *objInList.DisplayName == objName {
+			perfectMatch = append(perfectMatch, objInList)
+		}
+	}
+	if len(perfectMatch) > 0 {
+		if len(perfectMatch) > 1 {
+			return fmt.Errorf("Found multiple URL Reputation Severities with name '%s'", objName)
+		}
+		obj = perfectMatch[0]
+	} else if objID != "" {
+		return fmt.Errorf("URL Reputation Severity with ID '%s' was not found", objID)
+	} else if len(prefixMatch) > 0 {
+		if len(prefixMatch) > 1 {
+			return fmt.Errorf("Found multiple URL Reputation Severities with name starting with '%s'", objName)
+		}
+		obj = prefixMatch[0]
+	} else {
+		return fmt.Errorf("URL Reputation Severity with name '%s' was not found", objName)
+	}
+
+	d.SetId(*obj.Id)
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	d.Set("path", obj.Path)
+	d.Set("reputation_severity_id", obj.ReputationSeverityId)
+	d.Set("name", obj.Name)
+	d.Set("min_reputation", obj.MinReputation)
+	d.Set("max_reputation", obj.MaxReputation)
+	return nil
+}
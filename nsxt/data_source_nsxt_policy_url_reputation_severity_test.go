@@ -0,0 +1,44 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyURLReputationSeverity_basic(t *testing.T) {
+	name := "High Risk"
+	testResourceName := "data.nsxt_policy_url_reputation_severity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccNSXVersion(t, "3.1.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyURLReputationSeverityReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "reputation_severity_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "min_reputation"),
+					resource.TestCheckResourceAttrSet(testResourceName, "max_reputation"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyURLReputationSeverityReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_url_reputation_severity" "test" {
+  display_name = "%s"
+}`, name)
+}
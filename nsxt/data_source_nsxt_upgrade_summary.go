@@ -0,0 +1,126 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtUpgradeSummary() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtUpgradeSummaryRead,
+
+		Schema: map[string]*schema.Schema{
+			"system_version": {
+				Type:        schema.TypeString,
+				Description: "Current system version",
+				Computed:    true,
+			},
+			"target_version": {
+				Type:        schema.TypeString,
+				Description: "Target system version",
+				Computed:    true,
+			},
+			"upgrade_bundle_file_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the last successfully uploaded upgrade bundle file",
+				Computed:    true,
+			},
+			"upgrade_coordinator_version": {
+				Type:        schema.TypeString,
+				Description: "Current version of upgrade coordinator",
+				Computed:    true,
+			},
+			"upgrade_status": {
+				Type:        schema.TypeString,
+				Description: "Overall upgrade status",
+				Computed:    true,
+			},
+			"component_status": {
+				Type:        schema.TypeList,
+				Description: "Upgrade status per component",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_type": {
+							Type:        schema.TypeString,
+							Description: "Component type",
+							Computed:    true,
+						},
+						"target_version": {
+							Type:        schema.TypeString,
+							Description: "Target component version",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Upgrade status of the component",
+							Computed:    true,
+						},
+						"percent_complete": {
+							Type:        schema.TypeFloat,
+							Description: "Indicator of upgrade progress in percent",
+							Computed:    true,
+						},
+						"details": {
+							Type:        schema.TypeString,
+							Description: "Details about the upgrade status",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtUpgradeSummaryRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return dataSourceNotSupportedError()
+	}
+
+	summary, _, err := nsxClient.UpgradeApi.GetUpgradeSummary(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error while reading upgrade summary: %v", err)
+	}
+
+	status, _, err := nsxClient.UpgradeApi.GetUpgradeStatusSummary(nsxClient.Context, nil)
+	if err != nil {
+		return fmt.Errorf("Error while reading upgrade status: %v", err)
+	}
+
+	d.Set("system_version", summary.SystemVersion)
+	d.Set("target_version", summary.TargetVersion)
+	d.Set("upgrade_bundle_file_name", summary.UpgradeBundleFileName)
+	d.Set("upgrade_coordinator_version", summary.UpgradeCoordinatorVersion)
+	d.Set("upgrade_status", summary.UpgradeStatus)
+
+	targetVersions := make(map[string]string)
+	for _, component := range summary.ComponentTargetVersions {
+		targetVersions[component.ComponentType] = component.TargetVersion
+	}
+
+	var componentList []map[string]interface{}
+	for _, component := range status.ComponentStatus {
+		elem := make(map[string]interface{})
+		elem["component_type"] = component.ComponentType
+		elem["target_version"] = targetVersions[component.ComponentType]
+		elem["status"] = component.Status
+		elem["percent_complete"] = float64(component.PercentComplete)
+		elem["details"] = component.Details
+		componentList = append(componentList, elem)
+	}
+
+	err = d.Set("component_status", componentList)
+	if err != nil {
+		return fmt.Errorf("Error while setting upgrade component status: %v", err)
+	}
+
+	d.SetId(newUUID())
+
+	return nil
+}
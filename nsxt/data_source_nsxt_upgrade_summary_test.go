@@ -0,0 +1,32 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtUpgradeSummary_basic(t *testing.T) {
+	testResourceName := "data.nsxt_upgrade_summary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "nsxt_upgrade_summary" "test" {
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "system_version"),
+					resource.TestCheckResourceAttrSet(testResourceName, "upgrade_coordinator_version"),
+					resource.TestCheckResourceAttrSet(testResourceName, "upgrade_status"),
+				),
+			},
+		},
+	})
+}
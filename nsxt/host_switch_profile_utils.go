@@ -0,0 +1,84 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Host switch profiles of all types share the same API path, and are
+// distinguished by resource_type in the payload
+
+func getHostSwitchProfileAPIPath(id string) string {
+	return "/host-switch-profiles/" + id
+}
+
+func createHostSwitchProfile(d *schema.ResourceData, m interface{}, profileName string, payload interface{}) error {
+	var result struct {
+		ID string `json:"id"`
+	}
+	_, err := managerAPIRequest(m, http.MethodPost, "/host-switch-profiles", payload, &result)
+	if err != nil {
+		return fmt.Errorf("Error during %s create: %v", profileName, err)
+	}
+
+	d.SetId(result.ID)
+	return nil
+}
+
+// Returns false if profile was not found, in which case ID is cleared
+func readHostSwitchProfile(d *schema.ResourceData, m interface{}, profileName string, result interface{}) (bool, error) {
+	id := d.Id()
+	if id == "" {
+		return false, fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := managerAPIRequest(m, http.MethodGet, getHostSwitchProfileAPIPath(id), nil, result)
+	if isManagerAPINotFound(resp) {
+		log.Printf("[DEBUG] %s %s not found", profileName, id)
+		d.SetId("")
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("Error during %s read: %v", profileName, err)
+	}
+
+	return true, nil
+}
+
+func updateHostSwitchProfile(d *schema.ResourceData, m interface{}, profileName string, payload interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	_, err := managerAPIRequest(m, http.MethodPut, getHostSwitchProfileAPIPath(id), payload, nil)
+	if err != nil {
+		return fmt.Errorf("Error during %s update: %v", profileName, err)
+	}
+
+	return nil
+}
+
+func deleteHostSwitchProfile(d *schema.ResourceData, m interface{}, profileName string) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := managerAPIRequest(m, http.MethodDelete, getHostSwitchProfileAPIPath(id), nil, nil)
+	if isManagerAPINotFound(resp) {
+		log.Printf("[DEBUG] %s %s not found", profileName, id)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during %s delete: %v", profileName, err)
+	}
+
+	return nil
+}
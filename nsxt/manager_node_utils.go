@@ -0,0 +1,107 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/administration"
+)
+
+const managerNodeServiceRunning = "running"
+
+// Node configuration of edge transport nodes is available via node proxy API,
+// which exposes the same paths under transport node URL
+const managerNodeTransportNodePathPrefix = "transport-nodes/"
+
+func getManagerNodeServiceEnabledSchema(serviceName string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: fmt.Sprintf("Flag to keep %s service running", serviceName),
+		Optional:    true,
+		Default:     true,
+	}
+}
+
+func getManagerNodeTransportNodeIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of edge transport node to configure. If not set, manager node the provider is connected to is configured",
+		Optional:    true,
+		ForceNew:    true,
+	}
+}
+
+// Returns node API path for manager node or edge transport node
+func getManagerNodeAPIPath(d *schema.ResourceData, path string) string {
+	transportNodeID := d.Get("transport_node_id").(string)
+	if transportNodeID == "" {
+		return path
+	}
+
+	return "/" + managerNodeTransportNodePathPrefix + transportNodeID + path
+}
+
+// Import ID for edge transport node configuration is transport-nodes/<node ID>,
+// optionally followed by /<object ID>. Any other ID refers to manager node.
+func getManagerNodeImporter(hasObjectID bool) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			importID := d.Id()
+			if !strings.HasPrefix(importID, managerNodeTransportNodePathPrefix) {
+				return []*schema.ResourceData{d}, nil
+			}
+
+			s := strings.Split(strings.TrimPrefix(importID, managerNodeTransportNodePathPrefix), "/")
+			if (hasObjectID && len(s) != 2) || (!hasObjectID && len(s) != 1) || s[0] == "" {
+				return nil, fmt.Errorf("Unexpected import ID %s", importID)
+			}
+
+			d.Set("transport_node_id", s[0])
+			if hasObjectID {
+				d.SetId(s[1])
+			}
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+func isManagerNodeServiceRunning(d *schema.ResourceData, m interface{}, service string) (bool, error) {
+	var status administration.NodeServiceStatusProperties
+	_, err := managerAPIRequest(m, http.MethodGet, getManagerNodeAPIPath(d, "/node/services/"+service+"/status"), nil, &status)
+	if err != nil {
+		return false, err
+	}
+
+	return status.RuntimeState == managerNodeServiceRunning, nil
+}
+
+// Starts or stops node service, according to desired state
+func updateManagerNodeServiceState(d *schema.ResourceData, m interface{}, serviceName string, service string, enabled bool) error {
+	running, err := isManagerNodeServiceRunning(d, m, service)
+	if err != nil {
+		return fmt.Errorf("Failed to read %s service status: %v", serviceName, err)
+	}
+
+	if running == enabled {
+		return nil
+	}
+
+	action := "stop"
+	if enabled {
+		action = "start"
+	}
+
+	log.Printf("[INFO] Setting %s service running state to %t", serviceName, enabled)
+	_, err = managerAPIRequest(m, http.MethodPost, getManagerNodeAPIPath(d, "/node/services/"+service+"?action="+action), nil, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to update %s service running state: %v", serviceName, err)
+	}
+
+	return nil
+}
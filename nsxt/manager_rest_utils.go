@@ -0,0 +1,119 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	api "github.com/vmware/go-vmware-nsxt"
+)
+
+// Sends request to NSX Manager API with payload built by the caller.
+// This is needed where go-vmware-nsxt models can not express the payload,
+// such as polymorphic types or fields with omitempty that need to be sent
+// with zero value.
+// If result is not nil, response body is decoded into it.
+// Similar to go-vmware-nsxt, error is returned for non-2xx status, and
+// the response is returned as well so that caller can check for 404.
+func managerAPIRequest(m interface{}, method string, path string, body interface{}, result interface{}) (*http.Response, error) {
+	nsxClient := m.(nsxtClients).NsxtClient
+	cfg := m.(nsxtClients).NsxtClientConfig
+	if nsxClient == nil || cfg == nil {
+		return nil, fmt.Errorf("NSX Manager client is not configured")
+	}
+
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Path may contain query parameters, such as action
+	requestURL, err := url.Parse(cfg.BasePath + path)
+	if err != nil {
+		return nil, err
+	}
+	requestURL.Host = cfg.Host
+	requestURL.Scheme = cfg.Scheme
+	if requestURL.Scheme == "" {
+		requestURL.Scheme = "https"
+	}
+
+	var response *http.Response
+	var responseBody []byte
+	maxRetries := cfg.RetriesConfiguration.MaxRetries
+	for attempt := 0; ; attempt++ {
+		request, err := http.NewRequest(method, requestURL.String(), bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+		request.Header.Set("Accept", "application/json")
+		if body != nil {
+			request.Header.Set("Content-Type", "application/json")
+		}
+		request.Header.Set("User-Agent", cfg.UserAgent)
+		if auth, ok := nsxClient.Context.Value(api.ContextBasicAuth).(api.BasicAuth); ok {
+			request.SetBasicAuth(auth.UserName, auth.Password)
+		}
+		for header, value := range cfg.DefaultHeader {
+			request.Header.Add(header, value)
+		}
+
+		response, err = cfg.HTTPClient.Do(request)
+		if err != nil {
+			return response, err
+		}
+		responseBody, err = ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return response, err
+		}
+
+		if attempt >= maxRetries || !isRetryableManagerStatus(cfg, response.StatusCode) {
+			break
+		}
+		log.Printf("[DEBUG] Retrying request %s %s because of status %d", method, path, response.StatusCode)
+		delay := cfg.RetriesConfiguration.RetryMinDelay * (attempt + 1)
+		if maxDelay := cfg.RetriesConfiguration.RetryMaxDelay; maxDelay > 0 && delay > maxDelay {
+			delay = maxDelay
+		}
+		time.Sleep(time.Duration(delay) * time.Millisecond)
+	}
+
+	if response.StatusCode >= 300 {
+		return response, fmt.Errorf("%s %s failed with status %s: %s", method, path, response.Status, responseBody)
+	}
+
+	if result != nil && len(responseBody) > 0 {
+		err := json.Unmarshal(responseBody, result)
+		if err != nil {
+			return response, fmt.Errorf("Failed to decode response for %s %s: %v", method, path, err)
+		}
+	}
+
+	return response, nil
+}
+
+func isRetryableManagerStatus(cfg *api.Configuration, status int) bool {
+	for _, s := range cfg.RetriesConfiguration.RetryOnStatuses {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
+
+func isManagerAPINotFound(response *http.Response) bool {
+	return response != nil && response.StatusCode == http.StatusNotFound
+}
@@ -135,6 +135,18 @@ func listPolicyResourcesByNsxID(connector *client.RestConnector, isGlobalManager
 	return searchLMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
 }
 
+func listPolicyRealizedResourcesByMPID(connector *client.RestConnector, mpID string) ([]*data.StructValue, error) {
+	query := fmt.Sprintf("resource_type:GenericPolicyRealizedResource AND realization_specific_identifier:%s", mpID)
+	return searchLMPolicyResources(connector, query)
+}
+
+func listPolicyResourcesByReferencedPath(connector *client.RestConnector, path string, additionalQuery *string) ([]*data.StructValue, error) {
+	// Rules reference groups and services by path, groups reference
+	// other objects via path expressions
+	query := fmt.Sprintf("(source_groups:\"%[1]s\" OR destination_groups:\"%[1]s\" OR services:\"%[1]s\" OR scope:\"%[1]s\" OR expression.paths:\"%[1]s\") AND marked_for_delete:false", path)
+	return searchLMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
+}
+
 func buildPolicyResourcesQuery(query *string, additionalQuery *string) *string {
 	if additionalQuery != nil && *additionalQuery != "" {
 		*query = *query + " AND " + *additionalQuery
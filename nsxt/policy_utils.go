@@ -4,12 +4,15 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
@@ -158,6 +161,100 @@ func nsxtDomainResourceImporter(d *schema.ResourceData, m interface{}) ([]*schem
 	return []*schema.ResourceData{d}, nil
 }
 
+// Returns policy path of the object realized as MP object with given ID,
+// which is the case for MP objects promoted to Policy. Only paths that
+// match pathPattern are considered.
+func getPolicyPathByMPID(connector *client.RestConnector, mpID string, pathPattern *regexp.Regexp) (string, error) {
+	results, err := listPolicyRealizedResourcesByMPID(connector, mpID)
+	if err != nil {
+		return "", err
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	for _, result := range results {
+		dataValue, errs := converter.ConvertToGolang(result, model.GenericPolicyRealizedResourceBindingType())
+		if errs != nil {
+			return "", errs[0]
+		}
+
+		if path := getMatchingPolicyPath(dataValue.(model.GenericPolicyRealizedResource).IntentPaths, pathPattern); path != "" {
+			return path, nil
+		}
+	}
+
+	return "", nil
+}
+
+// Returns first path that matches pathPattern, or empty string
+func getMatchingPolicyPath(paths []string, pathPattern *regexp.Regexp) string {
+	for _, path := range paths {
+		if pathPattern.MatchString(path) {
+			return path
+		}
+	}
+
+	return ""
+}
+
+// Importer that accepts either policy ID, or ID of MP object promoted
+// to Policy
+func nsxtPolicyMPPromotedResourceImporter(pathPattern *regexp.Regexp) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		if isPolicyGlobalManager(m) {
+			return schema.ImportStatePassthrough(d, m)
+		}
+
+		importID := d.Id()
+		path, err := getPolicyPathByMPID(getPolicyConnector(m), importID, pathPattern)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to resolve policy path for MP object %s: %v", importID, err)
+		}
+		if path != "" {
+			log.Printf("[INFO] Importing policy object %s promoted from MP object %s", path, importID)
+			d.SetId(getPolicyIDFromPath(path))
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// Same as above, for objects under domain
+func nsxtDomainMPPromotedResourceImporter(pathPattern *regexp.Regexp) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		importID := d.Id()
+		if isPolicyGlobalManager(m) || strings.Contains(importID, "/") {
+			return nsxtDomainResourceImporter(d, m)
+		}
+
+		path, err := getPolicyPathByMPID(getPolicyConnector(m), importID, pathPattern)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to resolve policy path for MP object %s: %v", importID, err)
+		}
+		if path != "" {
+			log.Printf("[INFO] Importing policy object %s promoted from MP object %s", path, importID)
+			domainImportID, err := getPolicyDomainImportIDFromPath(path)
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(domainImportID)
+		}
+
+		return nsxtDomainResourceImporter(d, m)
+	}
+}
+
+// Returns import ID in form <domain>/<id> for path of object under domain,
+// which is in form /infra/domains/<domain>/<type>/<id>
+func getPolicyDomainImportIDFromPath(path string) (string, error) {
+	tokens := strings.Split(path, "/")
+	if len(tokens) != 6 || tokens[0] != "" || tokens[1] != "infra" || tokens[2] != "domains" {
+		return "", fmt.Errorf("Unexpected policy path %s for object under domain", path)
+	}
+
+	return fmt.Sprintf("%s/%s", tokens[3], tokens[5]), nil
+}
+
 func isPolicyPath(policyPath string) bool {
 	pathSegs := strings.Split(policyPath, "/")
 	if len(pathSegs) < 4 {
@@ -253,6 +350,66 @@ func nsxtPolicyWaitForRealizationStateConf(connector *client.RestConnector, d *s
 	return stateConf
 }
 
+// Converts realization alarms raised for intent object with given path to warnings
+func getPolicyRealizedAlarmWarnings(path string, alarms []model.PolicyAlarmResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, alarm := range alarms {
+		detail := ""
+		if alarm.Message != nil {
+			detail = *alarm.Message
+		}
+		if alarm.ErrorDetails != nil && alarm.ErrorDetails.ErrorMessage != nil {
+			detail = *alarm.ErrorDetails.ErrorMessage
+			if alarm.ErrorDetails.ErrorCode != nil {
+				detail = fmt.Sprintf("%s (error code %d)", detail, *alarm.ErrorDetails.ErrorCode)
+			}
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Realization alarm raised for %s", path),
+			Detail:   detail,
+		})
+	}
+
+	return diags
+}
+
+// Returns alarms of realized entities for intent object with given path.
+// Failure to retrieve realized entities is logged and does not fail the read.
+func getPolicyRealizedAlarms(connector *client.RestConnector, path string) []model.PolicyAlarmResource {
+	result, err := realized_state.NewRealizedEntitiesClient(connector).List(path, nil)
+	if err != nil {
+		log.Printf("[WARNING] Failed to retrieve realization alarms for %s: %v", path, err)
+		return nil
+	}
+
+	var alarms []model.PolicyAlarmResource
+	for _, entity := range result.Results {
+		alarms = append(alarms, entity.Alarms...)
+	}
+
+	return alarms
+}
+
+// Wraps policy resource create, read or update to surface realization alarms
+// for the object. Create and update end with read of the object, hence alarms
+// are reported once per operation.
+func nsxtPolicyWithRealizedAlarms(operation func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		err := operation(d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		path := d.Get("path").(string)
+		if d.Id() == "" || path == "" || isPolicyGlobalManager(m) {
+			return nil
+		}
+
+		return getPolicyRealizedAlarmWarnings(path, getPolicyRealizedAlarms(getPolicyConnector(m), path))
+	}
+}
+
 func getPolicyEnforcementPointPath(m interface{}) string {
 	return "/infra/sites/default/enforcement-points/" + getPolicyEnforcementPoint(m)
 }
@@ -0,0 +1,85 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestGetPolicyRealizedAlarmWarnings(t *testing.T) {
+	path := "/infra/tier-1s/test"
+
+	diags := getPolicyRealizedAlarmWarnings(path, nil)
+	if len(diags) != 0 {
+		t.Fatalf("Expected no diagnostics for empty alarm list, got %v", diags)
+	}
+
+	message := "Edge cluster not configured"
+	errorMessage := "Gateway realization failed"
+	errorCode := int64(500030)
+	alarms := []model.PolicyAlarmResource{
+		{Message: &message},
+		{ErrorDetails: &model.PolicyApiError{ErrorMessage: &errorMessage, ErrorCode: &errorCode}},
+	}
+
+	diags = getPolicyRealizedAlarmWarnings(path, alarms)
+	if len(diags) != 2 {
+		t.Fatalf("Expected 2 diagnostics, got %d", len(diags))
+	}
+	for _, d := range diags {
+		if d.Severity != diag.Warning {
+			t.Errorf("Expected warning severity for %s", d.Detail)
+		}
+	}
+	if diags[0].Detail != message {
+		t.Errorf("Unexpected detail %s", diags[0].Detail)
+	}
+	if diags[1].Detail != "Gateway realization failed (error code 500030)" {
+		t.Errorf("Unexpected detail %s", diags[1].Detail)
+	}
+}
+
+func TestGetMatchingPolicyPath(t *testing.T) {
+	cases := []struct {
+		pattern  *regexp.Regexp
+		paths    []string
+		expected string
+	}{
+		{policySegmentPathPattern, []string{"/infra/tier-1s/t1", "/infra/segments/ls1"}, "/infra/segments/ls1"},
+		{policySegmentPathPattern, []string{"/infra/segments/ls1/segment-ports/p1"}, ""},
+		{policyGroupPathPattern, []string{"/infra/domains/default/groups/g1"}, "/infra/domains/default/groups/g1"},
+		{policyGroupPathPattern, []string{"/infra/domains/default/security-policies/s1"}, ""},
+		{policySecurityPolicyPathPattern, []string{"/infra/domains/default/security-policies/s1/rules/r1", "/infra/domains/default/security-policies/s1"}, "/infra/domains/default/security-policies/s1"},
+		{policyTier1GatewayPathPattern, []string{"/infra/tier-0s/t0", "/infra/tier-1s/t1"}, "/infra/tier-1s/t1"},
+		{policyTier1GatewayPathPattern, nil, ""},
+	}
+
+	for _, c := range cases {
+		path := getMatchingPolicyPath(c.paths, c.pattern)
+		if path != c.expected {
+			t.Errorf("Expected %q for paths %v and pattern %s, got %q", c.expected, c.paths, c.pattern, path)
+		}
+	}
+}
+
+func TestGetPolicyDomainImportIDFromPath(t *testing.T) {
+	importID, err := getPolicyDomainImportIDFromPath("/infra/domains/default/groups/g1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if importID != "default/g1" {
+		t.Errorf("Expected import ID default/g1, got %s", importID)
+	}
+
+	for _, path := range []string{"/infra/segments/ls1", "/infra/domains/default/groups", "infra/domains/default/groups/g1/x"} {
+		_, err = getPolicyDomainImportIDFromPath(path)
+		if err == nil {
+			t.Errorf("Expected error for path %s", path)
+		}
+	}
+}
@@ -11,7 +11,6 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
-	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,6 +35,9 @@ type nsxtClients struct {
 	CommonConfig commonProviderConfig
 	// NSX Manager client - based on go-vmware-nsxt SDK
 	NsxtClient *api.APIClient
+	// Configuration of NSX Manager client, used for requests with
+	// hand-built payloads
+	NsxtClientConfig *api.Configuration
 	// Data for NSX Policy client - based on vsphere-automation-sdk-go SDK
 	// First offering of Policy SDK does not support concurrent
 	// operations in single connector. In order to avoid heavy locks,
@@ -166,13 +168,11 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeList,
 				Optional:      true,
 				Description:   "license keys",
+				Deprecated:    "Use nsxt_license resource instead",
 				ConflictsWith: []string{"vmc_token"},
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
-					ValidateFunc: validation.StringMatch(
-						regexp.MustCompile(
-							"^[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}$"),
-						"Must be a valid nsx license key matching: ^[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}$"),
+					Type:         schema.TypeString,
+					ValidateFunc: validateLicenseKey(),
 				},
 			},
 			"client_auth_cert": {
@@ -207,6 +207,9 @@ func Provider() *schema.Provider {
 			"nsxt_ns_service":                       dataSourceNsxtNsService(),
 			"nsxt_ns_services":                      dataSourceNsxtNsServices(),
 			"nsxt_edge_cluster":                     dataSourceNsxtEdgeCluster(),
+			"nsxt_cluster_backup_status":            dataSourceNsxtClusterBackupStatus(),
+			"nsxt_licenses":                         dataSourceNsxtLicenses(),
+			"nsxt_upgrade_summary":                  dataSourceNsxtUpgradeSummary(),
 			"nsxt_certificate":                      dataSourceNsxtCertificate(),
 			"nsxt_ip_pool":                          dataSourceNsxtIPPool(),
 			"nsxt_firewall_section":                 dataSourceNsxtFirewallSection(),
@@ -246,6 +249,27 @@ func Provider() *schema.Provider {
 			"nsxt_policy_bfd_profile":               dataSourceNsxtPolicyBfdProfile(),
 			"nsxt_policy_intrusion_service_profile": dataSourceNsxtPolicyIntrusionServiceProfile(),
 			"nsxt_policy_lb_service":                dataSourceNsxtPolicyLbService(),
+			"nsxt_policy_url_category":              dataSourceNsxtPolicyURLCategory(),
+			"nsxt_policy_url_reputation_severity":   dataSourceNsxtPolicyURLReputationSeverity(),
+			"nsxt_policy_firewall_auto_drafts":      dataSourceNsxtPolicyFirewallAutoDrafts(),
+			"nsxt_policy_gateway_routing_table":     dataSourceNsxtPolicyGatewayRoutingTable(),
+			"nsxt_policy_gateway_forwarding_table":  dataSourceNsxtPolicyGatewayForwardingTable(),
+			"nsxt_policy_bgp_neighbor_status":       dataSourceNsxtPolicyBgpNeighborStatus(),
+			"nsxt_policy_ospf_neighbor_status":      dataSourceNsxtPolicyOspfNeighborStatus(),
+			"nsxt_policy_segment_arp_table":         dataSourceNsxtPolicySegmentArpTable(),
+			"nsxt_policy_segment_mac_table":         dataSourceNsxtPolicySegmentMacTable(),
+			"nsxt_policy_segment_tep_table":         dataSourceNsxtPolicySegmentTepTable(),
+			"nsxt_policy_segment_state":             dataSourceNsxtPolicySegmentState(),
+			"nsxt_policy_segment_statistics":        dataSourceNsxtPolicySegmentStatistics(),
+			"nsxt_policy_group_members":             dataSourceNsxtPolicyGroupMembers(),
+			"nsxt_policy_object_associations":       dataSourceNsxtPolicyObjectAssociations(),
+			"nsxt_policy_object_references":         dataSourceNsxtPolicyObjectReferences(),
+			"nsxt_policy_realized_alarms":           dataSourceNsxtPolicyRealizedAlarms(),
+			"nsxt_policy_lb_service_status":         dataSourceNsxtPolicyLbServiceStatus(),
+			"nsxt_policy_lb_virtual_server_status":  dataSourceNsxtPolicyLbVirtualServerStatus(),
+			"nsxt_policy_lb_pool_member_status":     dataSourceNsxtPolicyLbPoolMemberStatus(),
+			"nsxt_policy_lb_usage":                  dataSourceNsxtPolicyLbUsage(),
+			"nsxt_policy_tags":                      dataSourceNsxtPolicyTags(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -355,6 +379,39 @@ func Provider() *schema.Provider {
 			"nsxt_policy_ospf_config":                      resourceNsxtPolicyOspfConfig(),
 			"nsxt_policy_ospf_area":                        resourceNsxtPolicyOspfArea(),
 			"nsxt_policy_gateway_redistribution_config":    resourceNsxtPolicyGatewayRedistributionConfig(),
+			"nsxt_policy_metadata_proxy":                   resourceNsxtPolicyMetadataProxy(),
+			"nsxt_policy_dns_security_profile":             resourceNsxtPolicyDNSSecurityProfile(),
+			"nsxt_policy_dns_security_profile_binding":     resourceNsxtPolicyDNSSecurityProfileBinding(),
+			"nsxt_policy_firewall_exclude_list_member":     resourceNsxtPolicyFirewallExcludeListMember(),
+			"nsxt_policy_firewall_global_config":           resourceNsxtPolicyFirewallGlobalConfig(),
+			"nsxt_policy_global_config":                    resourceNsxtPolicyGlobalConfig(),
+			"nsxt_policy_firewall_draft":                   resourceNsxtPolicyFirewallDraft(),
+			"nsxt_policy_firewall_draft_publish":           resourceNsxtPolicyFirewallDraftPublish(),
+			"nsxt_compute_manager":                         resourceNsxtComputeManager(),
+			"nsxt_transport_node_profile":                  resourceNsxtTransportNodeProfile(),
+			"nsxt_transport_node_collection":               resourceNsxtTransportNodeCollection(),
+			"nsxt_transport_zone":                          resourceNsxtTransportZone(),
+			"nsxt_uplink_host_switch_profile":              resourceNsxtUplinkHostSwitchProfile(),
+			"nsxt_lldp_host_switch_profile":                resourceNsxtLldpHostSwitchProfile(),
+			"nsxt_edge_cluster":                            resourceNsxtEdgeCluster(),
+			"nsxt_edge_transport_node":                     resourceNsxtEdgeTransportNode(),
+			"nsxt_role_binding":                            resourceNsxtRoleBinding(),
+			"nsxt_principal_identity":                      resourceNsxtPrincipalIdentity(),
+			"nsxt_vidm_config":                             resourceNsxtVidmConfig(),
+			"nsxt_policy_certificate":                      resourceNsxtPolicyCertificate(),
+			"nsxt_policy_crl":                              resourceNsxtPolicyCrl(),
+			"nsxt_manager_node_ntp_config":                 resourceNsxtManagerNodeNtpConfig(),
+			"nsxt_manager_node_dns_config":                 resourceNsxtManagerNodeDNSConfig(),
+			"nsxt_manager_node_ssh_config":                 resourceNsxtManagerNodeSSHConfig(),
+			"nsxt_manager_node_snmp_config":                resourceNsxtManagerNodeSnmpConfig(),
+			"nsxt_manager_node_syslog_exporter":            resourceNsxtManagerNodeSyslogExporter(),
+			"nsxt_cluster_backup_config":                   resourceNsxtClusterBackupConfig(),
+			"nsxt_license":                                 resourceNsxtLicense(),
+			"nsxt_upgrade_plan":                            resourceNsxtUpgradePlan(),
+			"nsxt_mp_to_policy_promotion":                  resourceNsxtMPToPolicyPromotion(),
+			"nsxt_policy_traceflow":                        resourceNsxtPolicyTraceflow(),
+			"nsxt_policy_livetrace":                        resourceNsxtPolicyLiveTrace(),
+			"nsxt_policy_bulk_tag":                         resourceNsxtPolicyBulkTag(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -463,6 +520,7 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 	}
 
 	clients.NsxtClient = nsxClient
+	clients.NsxtClientConfig = &cfg
 
 	return initNSXVersion(nsxClient)
 }
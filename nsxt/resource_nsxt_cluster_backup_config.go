@@ -0,0 +1,361 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/cluster/backups"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var clusterBackupScheduleSchemaKeys = []string{"interval_schedule", "weekly_schedule"}
+
+func resourceNsxtClusterBackupConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtClusterBackupConfigCreate,
+		Read:   resourceNsxtClusterBackupConfigRead,
+		Update: resourceNsxtClusterBackupConfigUpdate,
+		Delete: resourceNsxtClusterBackupConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable automated backup",
+				Optional:    true,
+				Default:     true,
+			},
+			"passphrase": {
+				Type:        schema.TypeString,
+				Description: "Passphrase used to encrypt backup files",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"inventory_summary_interval": {
+				Type:         schema.TypeInt,
+				Description:  "Minimum number of seconds between each upload of inventory summary to backup server",
+				Optional:     true,
+				Default:      240,
+				ValidateFunc: validation.IntBetween(30, 86400),
+			},
+			"after_inventory_update_interval": {
+				Type:         schema.TypeInt,
+				Description:  "Number of seconds to wait after inventory update before starting automated backup",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(300, 86400),
+			},
+			"remote_file_server": {
+				Type:        schema.TypeList,
+				Description: "SFTP server to which backups will be sent",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server": {
+							Type:        schema.TypeString,
+							Description: "Hostname or IP address of the server",
+							Required:    true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Description:  "Server port",
+							Optional:     true,
+							Default:      22,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"directory_path": {
+							Type:        schema.TypeString,
+							Description: "Remote server directory to copy backup files to",
+							Required:    true,
+						},
+						"ssh_fingerprint": {
+							Type:        schema.TypeString,
+							Description: "Expected SHA256 ECDSA fingerprint of the server",
+							Required:    true,
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Description: "User name to authenticate with",
+							Required:    true,
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Description: "Password to authenticate with",
+							Required:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"interval_schedule": {
+				Type:         schema.TypeList,
+				Description:  "Take backups at regular intervals",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: clusterBackupScheduleSchemaKeys,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"seconds_between_backups": {
+							Type:         schema.TypeInt,
+							Description:  "Time interval in seconds between two consecutive automated backups",
+							Optional:     true,
+							Default:      3600,
+							ValidateFunc: validation.IntBetween(300, 86400),
+						},
+					},
+				},
+			},
+			"weekly_schedule": {
+				Type:         schema.TypeList,
+				Description:  "Take backups on a weekly schedule",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: clusterBackupScheduleSchemaKeys,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_of_week": {
+							Type:        schema.TypeSet,
+							Description: "Days of week to take backups on, where 0 is Sunday",
+							Required:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 6),
+							},
+						},
+						"hour_of_day": {
+							Type:         schema.TypeInt,
+							Description:  "Hour of day to take backups at",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"minute_of_day": {
+							Type:         schema.TypeInt,
+							Description:  "Minute of hour to take backups at",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getClusterBackupScheduleFromSchema(d *schema.ResourceData) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	var dataValue data.DataValue
+	var errs []error
+	if schedules := d.Get("weekly_schedule").([]interface{}); len(schedules) > 0 && schedules[0] != nil {
+		schedule := schedules[0].(map[string]interface{})
+		hour := int64(schedule["hour_of_day"].(int))
+		minute := int64(schedule["minute_of_day"].(int))
+		weeklySchedule := model.WeeklyBackupSchedule{
+			DaysOfWeek:   intList2int64List(schedule["days_of_week"].(*schema.Set).List()),
+			HourOfDay:    &hour,
+			MinuteOfDay:  &minute,
+			ResourceType: model.BackupSchedule_RESOURCE_TYPE_WEEKLYBACKUPSCHEDULE,
+		}
+		dataValue, errs = converter.ConvertToVapi(weeklySchedule, model.WeeklyBackupScheduleBindingType())
+	} else {
+		seconds := int64(3600)
+		if schedules := d.Get("interval_schedule").([]interface{}); len(schedules) > 0 && schedules[0] != nil {
+			seconds = int64(schedules[0].(map[string]interface{})["seconds_between_backups"].(int))
+		}
+		intervalSchedule := model.IntervalBackupSchedule{
+			SecondsBetweenBackups: &seconds,
+			ResourceType:          model.BackupSchedule_RESOURCE_TYPE_INTERVALBACKUPSCHEDULE,
+		}
+		dataValue, errs = converter.ConvertToVapi(intervalSchedule, model.IntervalBackupScheduleBindingType())
+	}
+
+	if errs != nil {
+		return nil, errs[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func setClusterBackupScheduleInSchema(d *schema.ResourceData, schedule *data.StructValue) error {
+	if schedule == nil {
+		return nil
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	base, errs := converter.ConvertToGolang(schedule, model.BackupScheduleBindingType())
+	if errs != nil {
+		return errs[0]
+	}
+
+	switch base.(model.BackupSchedule).ResourceType {
+	case model.BackupSchedule_RESOURCE_TYPE_WEEKLYBACKUPSCHEDULE:
+		obj, errs := converter.ConvertToGolang(schedule, model.WeeklyBackupScheduleBindingType())
+		if errs != nil {
+			return errs[0]
+		}
+		weeklySchedule := obj.(model.WeeklyBackupSchedule)
+		elem := make(map[string]interface{})
+		elem["days_of_week"] = weeklySchedule.DaysOfWeek
+		elem["hour_of_day"] = weeklySchedule.HourOfDay
+		elem["minute_of_day"] = weeklySchedule.MinuteOfDay
+		d.Set("weekly_schedule", []interface{}{elem})
+		d.Set("interval_schedule", nil)
+	case model.BackupSchedule_RESOURCE_TYPE_INTERVALBACKUPSCHEDULE:
+		obj, errs := converter.ConvertToGolang(schedule, model.IntervalBackupScheduleBindingType())
+		if errs != nil {
+			return errs[0]
+		}
+		intervalSchedule := obj.(model.IntervalBackupSchedule)
+		elem := make(map[string]interface{})
+		elem["seconds_between_backups"] = intervalSchedule.SecondsBetweenBackups
+		d.Set("interval_schedule", []interface{}{elem})
+		d.Set("weekly_schedule", nil)
+	}
+
+	return nil
+}
+
+func getClusterBackupConfigFromSchema(d *schema.ResourceData, enabled bool) (model.BackupConfiguration, error) {
+	passphrase := d.Get("passphrase").(string)
+	inventorySummaryInterval := int64(d.Get("inventory_summary_interval").(int))
+
+	server := d.Get("remote_file_server").([]interface{})[0].(map[string]interface{})
+	serverName := server["server"].(string)
+	port := int64(server["port"].(int))
+	directoryPath := server["directory_path"].(string)
+	sshFingerprint := server["ssh_fingerprint"].(string)
+	username := server["username"].(string)
+	password := server["password"].(string)
+	protocolName := model.FileTransferProtocol_PROTOCOL_NAME_SFTP
+	schemeName := model.FileTransferAuthenticationScheme_SCHEME_NAME_PASSWORD
+
+	schedule, err := getClusterBackupScheduleFromSchema(d)
+	if err != nil {
+		return model.BackupConfiguration{}, err
+	}
+
+	obj := model.BackupConfiguration{
+		BackupEnabled:            &enabled,
+		BackupSchedule:           schedule,
+		InventorySummaryInterval: &inventorySummaryInterval,
+		Passphrase:               &passphrase,
+		RemoteFileServer: &model.RemoteFileServer{
+			Server:        &serverName,
+			Port:          &port,
+			DirectoryPath: &directoryPath,
+			Protocol: &model.FileTransferProtocol{
+				ProtocolName:   &protocolName,
+				SshFingerprint: &sshFingerprint,
+				AuthenticationScheme: &model.FileTransferAuthenticationScheme{
+					SchemeName: &schemeName,
+					Username:   &username,
+					Password:   &password,
+				},
+			},
+		},
+	}
+
+	if afterInventoryUpdateInterval := int64(d.Get("after_inventory_update_interval").(int)); afterInventoryUpdateInterval > 0 {
+		obj.AfterInventoryUpdateInterval = &afterInventoryUpdateInterval
+	}
+
+	return obj, nil
+}
+
+func clusterBackupConfigUpdate(d *schema.ResourceData, m interface{}, enabled bool) error {
+	obj, err := getClusterBackupConfigFromSchema(d, enabled)
+	if err != nil {
+		return err
+	}
+
+	client := backups.NewConfigClient(getPolicyConnector(m))
+	_, err = client.Update(obj, nil, nil)
+	return err
+}
+
+func resourceNsxtClusterBackupConfigCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[INFO] Configuring Cluster Backup")
+	err := clusterBackupConfigUpdate(d, m, d.Get("enabled").(bool))
+	if err != nil {
+		return handleCreateError("Cluster Backup Config", "", err)
+	}
+
+	d.SetId(newUUID())
+
+	return resourceNsxtClusterBackupConfigRead(d, m)
+}
+
+func resourceNsxtClusterBackupConfigRead(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Cluster Backup Config ID")
+	}
+
+	client := backups.NewConfigClient(getPolicyConnector(m))
+	obj, err := client.Get()
+	if err != nil {
+		return handleReadError(d, "Cluster Backup Config", id, err)
+	}
+
+	d.Set("enabled", obj.BackupEnabled)
+	d.Set("inventory_summary_interval", obj.InventorySummaryInterval)
+	d.Set("after_inventory_update_interval", obj.AfterInventoryUpdateInterval)
+
+	// Passphrase and password are not returned by NSX, and are kept
+	// from configuration
+	if obj.RemoteFileServer != nil {
+		elem := make(map[string]interface{})
+		if servers := d.Get("remote_file_server").([]interface{}); len(servers) > 0 && servers[0] != nil {
+			elem["password"] = servers[0].(map[string]interface{})["password"]
+		}
+		elem["server"] = obj.RemoteFileServer.Server
+		elem["port"] = obj.RemoteFileServer.Port
+		elem["directory_path"] = obj.RemoteFileServer.DirectoryPath
+		if obj.RemoteFileServer.Protocol != nil {
+			elem["ssh_fingerprint"] = obj.RemoteFileServer.Protocol.SshFingerprint
+			if obj.RemoteFileServer.Protocol.AuthenticationScheme != nil {
+				elem["username"] = obj.RemoteFileServer.Protocol.AuthenticationScheme.Username
+			}
+		}
+		d.Set("remote_file_server", []interface{}{elem})
+	}
+
+	return setClusterBackupScheduleInSchema(d, obj.BackupSchedule)
+}
+
+func resourceNsxtClusterBackupConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	log.Printf("[INFO] Updating Cluster Backup config")
+	err := clusterBackupConfigUpdate(d, m, d.Get("enabled").(bool))
+	if err != nil {
+		return handleUpdateError("Cluster Backup Config", id, err)
+	}
+
+	return resourceNsxtClusterBackupConfigRead(d, m)
+}
+
+func resourceNsxtClusterBackupConfigDelete(d *schema.ResourceData, m interface{}) error {
+	// Backup configuration can not be removed, hence automated
+	// backups are disabled instead
+	id := d.Id()
+	log.Printf("[INFO] Disabling Cluster Backup")
+	err := clusterBackupConfigUpdate(d, m, false)
+	if err != nil {
+		return handleDeleteError("Cluster Backup Config", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNsxtClusterBackupConfigPreCheck(t *testing.T) {
+	testAccOnlyLocalManager(t)
+	testAccPreCheck(t)
+	testAccEnvDefined(t, "NSXT_TEST_BACKUP_SERVER")
+	testAccEnvDefined(t, "NSXT_TEST_BACKUP_SSH_FINGERPRINT")
+	testAccEnvDefined(t, "NSXT_TEST_BACKUP_USERNAME")
+	testAccEnvDefined(t, "NSXT_TEST_BACKUP_PASSWORD")
+}
+
+func TestAccResourceNsxtClusterBackupConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_cluster_backup_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccNsxtClusterBackupConfigPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtClusterBackupConfigTemplate(`
+  interval_schedule {
+    seconds_between_backups = 7200
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "remote_file_server.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "remote_file_server.0.server", os.Getenv("NSXT_TEST_BACKUP_SERVER")),
+					resource.TestCheckResourceAttr(testResourceName, "remote_file_server.0.port", "22"),
+					resource.TestCheckResourceAttr(testResourceName, "interval_schedule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "interval_schedule.0.seconds_between_backups", "7200"),
+					resource.TestCheckResourceAttr(testResourceName, "weekly_schedule.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtClusterBackupConfigTemplate(`
+  weekly_schedule {
+    days_of_week  = [0, 3]
+    hour_of_day   = 2
+    minute_of_day = 30
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "interval_schedule.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "weekly_schedule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "weekly_schedule.0.days_of_week.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "weekly_schedule.0.hour_of_day", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "weekly_schedule.0.minute_of_day", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtClusterBackupConfigTemplate(schedule string) string {
+	return fmt.Sprintf(`
+resource "nsxt_cluster_backup_config" "test" {
+  passphrase = "Terraform-Test-Passphrase1!"
+
+  remote_file_server {
+    server          = "%s"
+    directory_path  = "/tmp/nsx-backup"
+    ssh_fingerprint = "%s"
+    username        = "%s"
+    password        = "%s"
+  }
+%s
+}`, os.Getenv("NSXT_TEST_BACKUP_SERVER"), os.Getenv("NSXT_TEST_BACKUP_SSH_FINGERPRINT"), os.Getenv("NSXT_TEST_BACKUP_USERNAME"), os.Getenv("NSXT_TEST_BACKUP_PASSWORD"), schedule)
+}
@@ -0,0 +1,277 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+const (
+	computeManagerRegistered        = "REGISTERED"
+	computeManagerRegistering       = "REGISTERING"
+	computeManagerConnectionUp      = "UP"
+	computeManagerUsernamePassword  = "UsernamePasswordLoginCredential"
+	computeManagerVCenterOriginType = "vCenter"
+)
+
+// go-vmware-nsxt LoginCredential model only carries credential type,
+// hence the credential is sent with the type below
+type computeManagerCredential struct {
+	CredentialType string `json:"credential_type"`
+	Username       string `json:"username"`
+	Password       string `json:"password"`
+	Thumbprint     string `json:"thumbprint"`
+}
+
+func resourceNsxtComputeManager() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtComputeManagerCreate,
+		Read:   resourceNsxtComputeManagerRead,
+		Update: resourceNsxtComputeManagerUpdate,
+		Delete: resourceNsxtComputeManagerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"server": {
+				Type:        schema.TypeString,
+				Description: "IP address or hostname of the vCenter server",
+				Required:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "Username for vCenter login",
+				Required:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "Password for vCenter login",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"thumbprint": {
+				Type:        schema.TypeString,
+				Description: "SHA-256 thumbprint of vCenter server certificate",
+				Required:    true,
+			},
+			"origin_type": {
+				Type:        schema.TypeString,
+				Description: "Compute manager type",
+				Computed:    true,
+			},
+			"registration_status": {
+				Type:        schema.TypeString,
+				Description: "Registration status of the compute manager",
+				Computed:    true,
+			},
+			"connection_status": {
+				Type:        schema.TypeString,
+				Description: "Connection status of the compute manager",
+				Computed:    true,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Description: "Version of the compute manager",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getComputeManagerFromSchema(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"resource_type": "ComputeManager",
+		"display_name":  d.Get("display_name").(string),
+		"description":   d.Get("description").(string),
+		"tags":          getTagsFromSchema(d),
+		"server":        d.Get("server").(string),
+		"origin_type":   computeManagerVCenterOriginType,
+		"credential": computeManagerCredential{
+			CredentialType: computeManagerUsernamePassword,
+			Username:       d.Get("username").(string),
+			Password:       d.Get("password").(string),
+			Thumbprint:     d.Get("thumbprint").(string),
+		},
+	}
+}
+
+func getComputeManagerStatusErrors(errors []manager.ErrorInfo) string {
+	var messages []string
+	for _, e := range errors {
+		messages = append(messages, e.ErrorMessage)
+	}
+	return strings.Join(messages, "; ")
+}
+
+func waitForComputeManagerRegistration(id string, m interface{}, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"ready"},
+		Refresh: func() (interface{}, string, error) {
+			var status manager.ComputeManagerStatus
+			_, err := managerAPIRequest(m, http.MethodGet, "/fabric/compute-managers/"+id+"/status", nil, &status)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error while querying Compute Manager %s status: %v", id, err)
+			}
+
+			log.Printf("[DEBUG] Compute Manager %s registration status %s, connection status %s", id, status.RegistrationStatus, status.ConnectionStatus)
+			if status.RegistrationStatus == computeManagerRegistered && status.ConnectionStatus == computeManagerConnectionUp {
+				return status, "ready", nil
+			}
+			if len(status.RegistrationErrors) > 0 {
+				return status, status.RegistrationStatus, fmt.Errorf("Compute Manager %s registration failed: %s", id, getComputeManagerStatusErrors(status.RegistrationErrors))
+			}
+			if status.RegistrationStatus != computeManagerRegistering && status.RegistrationStatus != computeManagerRegistered {
+				return status, status.RegistrationStatus, fmt.Errorf("Compute Manager %s is in registration status %s", id, status.RegistrationStatus)
+			}
+			return status, "pending", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceNsxtComputeManagerCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	payload := getComputeManagerFromSchema(d)
+
+	log.Printf("[INFO] Registering Compute Manager %s", d.Get("server").(string))
+	var computeManager manager.ComputeManager
+	_, err := managerAPIRequest(m, http.MethodPost, "/fabric/compute-managers", payload, &computeManager)
+	if err != nil {
+		return fmt.Errorf("Error during Compute Manager create: %v", err)
+	}
+
+	d.SetId(computeManager.Id)
+	err = waitForComputeManagerRegistration(computeManager.Id, m, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtComputeManagerRead(d, m)
+}
+
+func resourceNsxtComputeManagerRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	computeManager, resp, err := nsxClient.FabricApi.ReadComputeManager(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Compute Manager %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during Compute Manager read: %v", err)
+	}
+
+	d.Set("revision", computeManager.Revision)
+	d.Set("description", computeManager.Description)
+	d.Set("display_name", computeManager.DisplayName)
+	setTagsInSchema(d, computeManager.Tags)
+	d.Set("server", computeManager.Server)
+	d.Set("origin_type", computeManager.OriginType)
+	// Credentials are not returned by NSX, and are kept from configuration
+
+	status, _, err := nsxClient.FabricApi.ReadComputeManagerStatus(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during Compute Manager status read: %v", err)
+	}
+	d.Set("registration_status", status.RegistrationStatus)
+	d.Set("connection_status", status.ConnectionStatus)
+	d.Set("version", status.Version)
+
+	return nil
+}
+
+func resourceNsxtComputeManagerUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	// NSX requires the credential on every update
+	payload := getComputeManagerFromSchema(d)
+	payload["_revision"] = int64(d.Get("revision").(int))
+
+	_, err := managerAPIRequest(m, http.MethodPut, "/fabric/compute-managers/"+id, payload, nil)
+	if err != nil {
+		return fmt.Errorf("Error during Compute Manager update: %v", err)
+	}
+
+	err = waitForComputeManagerRegistration(id, m, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtComputeManagerRead(d, m)
+}
+
+func resourceNsxtComputeManagerDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.FabricApi.DeleteComputeManager(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Compute Manager %s not found", id)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during Compute Manager delete: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccNsxtComputeManagerPreCheck(t *testing.T) {
+	testAccOnlyLocalManager(t)
+	testAccTestMP(t)
+	testAccPreCheck(t)
+	testAccEnvDefined(t, "NSXT_TEST_VCENTER_SERVER")
+	testAccEnvDefined(t, "NSXT_TEST_VCENTER_USERNAME")
+	testAccEnvDefined(t, "NSXT_TEST_VCENTER_PASSWORD")
+	testAccEnvDefined(t, "NSXT_TEST_VCENTER_THUMBPRINT")
+}
+
+func TestAccResourceNsxtComputeManager_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_compute_manager.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccNsxtComputeManagerPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtComputeManagerCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtComputeManagerTemplate(name, "Acceptance Test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtComputeManagerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "server", os.Getenv("NSXT_TEST_VCENTER_SERVER")),
+					resource.TestCheckResourceAttr(testResourceName, "origin_type", "vCenter"),
+					resource.TestCheckResourceAttr(testResourceName, "registration_status", "REGISTERED"),
+					resource.TestCheckResourceAttr(testResourceName, "connection_status", "UP"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "version"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtComputeManagerTemplate(updateName, "Acceptance Test Update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtComputeManagerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "registration_status", "REGISTERED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtComputeManager_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_compute_manager.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccNsxtComputeManagerPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtComputeManagerCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtComputeManagerTemplate(name, "Acceptance Test"),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"username", "password", "thumbprint"},
+			},
+		},
+	})
+}
+
+func testAccNsxtComputeManagerExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Compute Manager resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Compute Manager resource ID not set in resources")
+		}
+
+		_, responseCode, err := nsxClient.FabricApi.ReadComputeManager(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Compute Manager %s: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if Compute Manager %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtComputeManagerCheckDestroy(state *terraform.State) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_compute_manager" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		_, responseCode, err := nsxClient.FabricApi.ReadComputeManager(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode != nil && responseCode.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("Error while retrieving Compute Manager %s: %v", resourceID, err)
+		}
+
+		return fmt.Errorf("Compute Manager %s still exists", resourceID)
+	}
+	return nil
+}
+
+func testAccNsxtComputeManagerTemplate(name string, description string) string {
+	return fmt.Sprintf(`
+resource "nsxt_compute_manager" "test" {
+  display_name = "%s"
+  description  = "%s"
+  server       = "%s"
+  username     = "%s"
+  password     = "%s"
+  thumbprint   = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, description, os.Getenv("NSXT_TEST_VCENTER_SERVER"), os.Getenv("NSXT_TEST_VCENTER_USERNAME"),
+		os.Getenv("NSXT_TEST_VCENTER_PASSWORD"), os.Getenv("NSXT_TEST_VCENTER_THUMBPRINT"))
+}
@@ -0,0 +1,302 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+const edgeClusterHAProfileResourceType = "EdgeHighAvailabilityProfile"
+
+func resourceNsxtEdgeCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtEdgeClusterCreate,
+		Read:   resourceNsxtEdgeClusterRead,
+		Update: resourceNsxtEdgeClusterUpdate,
+		Delete: resourceNsxtEdgeClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"edge_ha_profile_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the Edge High Availability Profile bound to this cluster",
+				Optional:    true,
+				Computed:    true,
+			},
+			"member": {
+				Type:        schema.TypeList,
+				Description: "Edge Transport Nodes that are members of this cluster",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"transport_node_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the Edge Transport Node",
+							Required:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "The display name of this cluster member",
+							Optional:    true,
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description of this cluster member",
+							Optional:    true,
+						},
+						"member_index": {
+							Type:        schema.TypeInt,
+							Description: "System generated index for the cluster member",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"deployment_type": {
+				Type:        schema.TypeString,
+				Description: "The deployment type of edge cluster members",
+				Computed:    true,
+			},
+			"member_node_type": {
+				Type:        schema.TypeString,
+				Description: "Type of transport nodes",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Member index is omitempty in go-vmware-nsxt model, hence index 0 of an
+// existing member would not be sent. Members are built as maps instead.
+func getEdgeClusterMembersFromSchema(d *schema.ResourceData, existingMembers []manager.EdgeClusterMember) []map[string]interface{} {
+	// Member index is assigned by NSX, and needs to be preserved for
+	// existing members on update
+	memberIndexes := make(map[string]int32)
+	for _, member := range existingMembers {
+		memberIndexes[member.TransportNodeId] = member.MemberIndex
+	}
+
+	members := make([]map[string]interface{}, 0)
+	for _, member := range d.Get("member").([]interface{}) {
+		data := member.(map[string]interface{})
+		transportNodeID := data["transport_node_id"].(string)
+		elem := map[string]interface{}{
+			"transport_node_id": transportNodeID,
+			"display_name":      data["display_name"].(string),
+			"description":       data["description"].(string),
+		}
+		if index, ok := memberIndexes[transportNodeID]; ok {
+			elem["member_index"] = index
+		}
+		members = append(members, elem)
+	}
+
+	return members
+}
+
+// Members are reported in configured order, followed by members that are
+// not in configuration ordered by member index
+func setEdgeClusterMembersInSchema(d *schema.ResourceData, members []manager.EdgeClusterMember) error {
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].MemberIndex < members[j].MemberIndex
+	})
+
+	var configuredIDs []string
+	for _, member := range d.Get("member").([]interface{}) {
+		configuredIDs = append(configuredIDs, member.(map[string]interface{})["transport_node_id"].(string))
+	}
+	position := func(member manager.EdgeClusterMember) int {
+		for i, id := range configuredIDs {
+			if id == member.TransportNodeId {
+				return i
+			}
+		}
+		return len(configuredIDs)
+	}
+	sort.SliceStable(members, func(i, j int) bool {
+		return position(members[i]) < position(members[j])
+	})
+
+	var membersList []map[string]interface{}
+	for _, member := range members {
+		elem := make(map[string]interface{})
+		elem["transport_node_id"] = member.TransportNodeId
+		elem["display_name"] = member.DisplayName
+		elem["description"] = member.Description
+		elem["member_index"] = member.MemberIndex
+		membersList = append(membersList, elem)
+	}
+
+	return d.Set("member", membersList)
+}
+
+func getEdgeClusterFromSchema(d *schema.ResourceData, existingMembers []manager.EdgeClusterMember) map[string]interface{} {
+	payload := map[string]interface{}{
+		"resource_type": "EdgeCluster",
+		"description":   d.Get("description").(string),
+		"display_name":  d.Get("display_name").(string),
+		"tags":          getTagsFromSchema(d),
+		"members":       getEdgeClusterMembersFromSchema(d, existingMembers),
+	}
+	if bindings := getEdgeClusterProfileBindingsFromSchema(d); len(bindings) > 0 {
+		payload["cluster_profile_bindings"] = bindings
+	}
+
+	return payload
+}
+
+func getEdgeClusterProfileBindingsFromSchema(d *schema.ResourceData) []manager.ClusterProfileTypeIdEntry {
+	profileID := d.Get("edge_ha_profile_id").(string)
+	if profileID == "" {
+		return nil
+	}
+
+	return []manager.ClusterProfileTypeIdEntry{{
+		ProfileId:    profileID,
+		ResourceType: edgeClusterHAProfileResourceType,
+	}}
+}
+
+func setEdgeClusterProfileBindingsInSchema(d *schema.ResourceData, bindings []manager.ClusterProfileTypeIdEntry) {
+	for _, binding := range bindings {
+		if binding.ResourceType == edgeClusterHAProfileResourceType {
+			d.Set("edge_ha_profile_id", binding.ProfileId)
+			return
+		}
+	}
+
+	d.Set("edge_ha_profile_id", "")
+}
+
+func resourceNsxtEdgeClusterCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	payload := getEdgeClusterFromSchema(d, nil)
+
+	log.Printf("[INFO] Creating Edge Cluster %s", payload["display_name"])
+	var edgeCluster manager.EdgeCluster
+	resp, err := managerAPIRequest(m, http.MethodPost, "/edge-clusters", payload, &edgeCluster)
+	if err != nil {
+		return fmt.Errorf("Error during EdgeCluster create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during EdgeCluster create: %v", resp.StatusCode)
+	}
+	d.SetId(edgeCluster.Id)
+
+	return resourceNsxtEdgeClusterRead(d, m)
+}
+
+func resourceNsxtEdgeClusterRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	edgeCluster, resp, err := nsxClient.NetworkTransportApi.ReadEdgeCluster(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] EdgeCluster %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during EdgeCluster read: %v", err)
+	}
+
+	d.Set("revision", edgeCluster.Revision)
+	d.Set("description", edgeCluster.Description)
+	d.Set("display_name", edgeCluster.DisplayName)
+	setTagsInSchema(d, edgeCluster.Tags)
+	setEdgeClusterProfileBindingsInSchema(d, edgeCluster.ClusterProfileBindings)
+	d.Set("deployment_type", edgeCluster.DeploymentType)
+	d.Set("member_node_type", edgeCluster.MemberNodeType)
+	err = setEdgeClusterMembersInSchema(d, edgeCluster.Members)
+	if err != nil {
+		return fmt.Errorf("Error during EdgeCluster members set in schema: %v", err)
+	}
+
+	return nil
+}
+
+func resourceNsxtEdgeClusterUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	existingCluster, resp, err := nsxClient.NetworkTransportApi.ReadEdgeCluster(nsxClient.Context, id)
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during EdgeCluster read: %v", err)
+	}
+
+	payload := getEdgeClusterFromSchema(d, existingCluster.Members)
+	payload["_revision"] = int64(d.Get("revision").(int))
+
+	log.Printf("[INFO] Updating Edge Cluster %s", id)
+	resp, err = managerAPIRequest(m, http.MethodPut, "/edge-clusters/"+id, payload, nil)
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during EdgeCluster update: %v", err)
+	}
+
+	return resourceNsxtEdgeClusterRead(d, m)
+}
+
+func resourceNsxtEdgeClusterDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.NetworkTransportApi.DeleteEdgeCluster(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during EdgeCluster delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] EdgeCluster %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtEdgeCluster_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_edge_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXEdgeClusterCheckDestroy(state, updateName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXEdgeClusterCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXEdgeClusterExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "member.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_ha_profile_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNSXEdgeClusterUpdateTemplate(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXEdgeClusterExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "member.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_ha_profile_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtEdgeCluster_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_edge_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXEdgeClusterCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXEdgeClusterCreateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXEdgeClusterExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Edge Cluster resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Edge Cluster resource ID not set in resources ")
+		}
+
+		edgeCluster, responseCode, err := nsxClient.NetworkTransportApi.ReadEdgeCluster(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Edge Cluster ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if Edge Cluster %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == edgeCluster.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("Edge Cluster %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXEdgeClusterCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_edge_cluster" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		edgeCluster, responseCode, err := nsxClient.NetworkTransportApi.ReadEdgeCluster(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving Edge Cluster ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == edgeCluster.DisplayName {
+			return fmt.Errorf("Edge Cluster %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXEdgeClusterCreateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_edge_cluster" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func testAccNSXEdgeClusterUpdateTemplate(updatedName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_edge_cluster" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test Update"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  tag {
+    scope = "scope2"
+    tag   = "tag2"
+  }
+}`, updatedName)
+}
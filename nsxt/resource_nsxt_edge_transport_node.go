@@ -0,0 +1,570 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+var edgeTransportNodeFormFactorValues = []string{"SMALL", "MEDIUM", "LARGE", "XLARGE"}
+
+const (
+	transportNodeStatePending    = "pending"
+	transportNodeStateInProgress = "in_progress"
+	transportNodeStateSuccess    = "success"
+)
+
+// go-vmware-nsxt TransportNode model has no node deployment info, hence
+// edge transport node payload is described by the types below
+
+type edgeNodeUserSettings struct {
+	CliUsername   string `json:"cli_username,omitempty"`
+	CliPassword   string `json:"cli_password,omitempty"`
+	RootPassword  string `json:"root_password,omitempty"`
+	AuditUsername string `json:"audit_username,omitempty"`
+	AuditPassword string `json:"audit_password,omitempty"`
+}
+
+type edgeNodeVMDeploymentConfig struct {
+	PlacementType           string             `json:"placement_type"`
+	VcID                    string             `json:"vc_id"`
+	ComputeID               string             `json:"compute_id"`
+	StorageID               string             `json:"storage_id"`
+	HostID                  string             `json:"host_id,omitempty"`
+	ManagementNetworkID     string             `json:"management_network_id"`
+	DataNetworkIDs          []string           `json:"data_network_ids"`
+	ManagementPortSubnets   []manager.IpSubnet `json:"management_port_subnets,omitempty"`
+	DefaultGatewayAddresses []string           `json:"default_gateway_addresses,omitempty"`
+}
+
+type edgeNodeDeploymentConfig struct {
+	FormFactor         string                     `json:"form_factor"`
+	NodeUserSettings   *edgeNodeUserSettings      `json:"node_user_settings,omitempty"`
+	VMDeploymentConfig edgeNodeVMDeploymentConfig `json:"vm_deployment_config"`
+}
+
+type edgeNodeSettings struct {
+	Hostname          string   `json:"hostname"`
+	NtpServers        []string `json:"ntp_servers"`
+	DNSServers        []string `json:"dns_servers"`
+	SearchDomains     []string `json:"search_domains"`
+	EnableSSH         bool     `json:"enable_ssh"`
+	AllowSSHRootLogin bool     `json:"allow_ssh_root_login"`
+}
+
+type edgeNodeDeploymentInfo struct {
+	ResourceType     string                   `json:"resource_type"`
+	ID               string                   `json:"id,omitempty"`
+	DisplayName      string                   `json:"display_name,omitempty"`
+	DeploymentConfig edgeNodeDeploymentConfig `json:"deployment_config"`
+	NodeSettings     edgeNodeSettings         `json:"node_settings"`
+}
+
+type edgeTransportNode struct {
+	ID                 string                       `json:"id,omitempty"`
+	Revision           int64                        `json:"_revision"`
+	ResourceType       string                       `json:"resource_type"`
+	DisplayName        string                       `json:"display_name,omitempty"`
+	Description        string                       `json:"description"`
+	Tags               []common.Tag                 `json:"tags"`
+	HostSwitchSpec     *transportNodeHostSwitchSpec `json:"host_switch_spec,omitempty"`
+	NodeDeploymentInfo *edgeNodeDeploymentInfo      `json:"node_deployment_info,omitempty"`
+}
+
+func resourceNsxtEdgeTransportNode() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtEdgeTransportNodeCreate,
+		Read:   resourceNsxtEdgeTransportNodeRead,
+		Update: resourceNsxtEdgeTransportNodeUpdate,
+		Delete: resourceNsxtEdgeTransportNodeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"form_factor": {
+				Type:         schema.TypeString,
+				Description:  "Form factor of the edge VM",
+				Optional:     true,
+				Default:      "MEDIUM",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(edgeTransportNodeFormFactorValues, false),
+			},
+			"credentials": {
+				Type:        schema.TypeList,
+				Description: "Users of the edge VM, only applied upon deployment",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cli_username": {
+							Type:        schema.TypeString,
+							Description: "CLI user name",
+							Optional:    true,
+							Default:     "admin",
+						},
+						"cli_password": {
+							Type:        schema.TypeString,
+							Description: "CLI password",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"root_password": {
+							Type:        schema.TypeString,
+							Description: "Root password",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"audit_username": {
+							Type:        schema.TypeString,
+							Description: "Audit user name",
+							Optional:    true,
+						},
+						"audit_password": {
+							Type:        schema.TypeString,
+							Description: "Audit password",
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"vm_deployment_config": {
+				Type:        schema.TypeList,
+				Description: "vSphere placement of the edge VM",
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vc_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the compute manager to deploy on",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"compute_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the cluster or resource pool to deploy on",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"storage_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the datastore to deploy on",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"host_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the host to deploy on",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"management_network_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the network for management interface",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"data_network_ids": {
+							Type:        schema.TypeList,
+							Description: "IDs of networks for data path interfaces",
+							Required:    true,
+							ForceNew:    true,
+							MinItems:    1,
+							MaxItems:    3,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"management_port_subnet": {
+							Type:        schema.TypeList,
+							Description: "Static IP configuration of management interface. If not set, DHCP is used",
+							Optional:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_addresses": {
+										Type:        schema.TypeList,
+										Description: "IP addresses",
+										Required:    true,
+										ForceNew:    true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateSingleIP(),
+										},
+									},
+									"prefix_length": {
+										Type:         schema.TypeInt,
+										Description:  "Subnet prefix length",
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 128),
+									},
+								},
+							},
+						},
+						"default_gateway_addresses": {
+							Type:        schema.TypeList,
+							Description: "Default gateway addresses of management interface",
+							Optional:    true,
+							ForceNew:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateSingleIP(),
+							},
+						},
+					},
+				},
+			},
+			"node_settings": {
+				Type:        schema.TypeList,
+				Description: "Settings of the edge node",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:        schema.TypeString,
+							Description: "Host name of the edge VM",
+							Required:    true,
+						},
+						"ntp_servers": {
+							Type:        schema.TypeList,
+							Description: "NTP servers",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"dns_servers": {
+							Type:        schema.TypeList,
+							Description: "DNS servers",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateSingleIP(),
+							},
+						},
+						"search_domains": {
+							Type:        schema.TypeList,
+							Description: "Search domains",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"enable_ssh": {
+							Type:        schema.TypeBool,
+							Description: "Flag to enable SSH",
+							Optional:    true,
+							Default:     false,
+						},
+						"allow_ssh_root_login": {
+							Type:        schema.TypeBool,
+							Description: "Flag to allow SSH login as root",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"host_switch": getTransportNodeHostSwitchSchema(false),
+			"deployment_state": {
+				Type:        schema.TypeString,
+				Description: "Deployment state of the transport node",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getEdgeTransportNodeFromSchema(d *schema.ResourceData) edgeTransportNode {
+	vmConfig := d.Get("vm_deployment_config").([]interface{})[0].(map[string]interface{})
+	vmDeploymentConfig := edgeNodeVMDeploymentConfig{
+		PlacementType:           "VsphereDeploymentConfig",
+		VcID:                    vmConfig["vc_id"].(string),
+		ComputeID:               vmConfig["compute_id"].(string),
+		StorageID:               vmConfig["storage_id"].(string),
+		HostID:                  vmConfig["host_id"].(string),
+		ManagementNetworkID:     vmConfig["management_network_id"].(string),
+		DataNetworkIDs:          interface2StringList(vmConfig["data_network_ids"].([]interface{})),
+		DefaultGatewayAddresses: interface2StringList(vmConfig["default_gateway_addresses"].([]interface{})),
+	}
+	for _, subnet := range vmConfig["management_port_subnet"].([]interface{}) {
+		data := subnet.(map[string]interface{})
+		vmDeploymentConfig.ManagementPortSubnets = append(vmDeploymentConfig.ManagementPortSubnets, manager.IpSubnet{
+			IpAddresses:  interface2StringList(data["ip_addresses"].([]interface{})),
+			PrefixLength: int64(data["prefix_length"].(int)),
+		})
+	}
+
+	credentials := d.Get("credentials").([]interface{})[0].(map[string]interface{})
+	settings := d.Get("node_settings").([]interface{})[0].(map[string]interface{})
+	displayName := d.Get("display_name").(string)
+	hostSwitchSpec := getTransportNodeHostSwitchSpecFromSchema(d, false)
+
+	return edgeTransportNode{
+		Revision:       int64(d.Get("revision").(int)),
+		ResourceType:   "TransportNode",
+		DisplayName:    displayName,
+		Description:    d.Get("description").(string),
+		Tags:           getTagsFromSchema(d),
+		HostSwitchSpec: &hostSwitchSpec,
+		NodeDeploymentInfo: &edgeNodeDeploymentInfo{
+			ResourceType: "EdgeNode",
+			DisplayName:  displayName,
+			DeploymentConfig: edgeNodeDeploymentConfig{
+				FormFactor: d.Get("form_factor").(string),
+				NodeUserSettings: &edgeNodeUserSettings{
+					CliUsername:   credentials["cli_username"].(string),
+					CliPassword:   credentials["cli_password"].(string),
+					RootPassword:  credentials["root_password"].(string),
+					AuditUsername: credentials["audit_username"].(string),
+					AuditPassword: credentials["audit_password"].(string),
+				},
+				VMDeploymentConfig: vmDeploymentConfig,
+			},
+			NodeSettings: edgeNodeSettings{
+				Hostname:          settings["hostname"].(string),
+				NtpServers:        interface2StringList(settings["ntp_servers"].([]interface{})),
+				DNSServers:        interface2StringList(settings["dns_servers"].([]interface{})),
+				SearchDomains:     interface2StringList(settings["search_domains"].([]interface{})),
+				EnableSSH:         settings["enable_ssh"].(bool),
+				AllowSSHRootLogin: settings["allow_ssh_root_login"].(bool),
+			},
+		},
+	}
+}
+
+func waitForEdgeTransportNodeDeployment(d *schema.ResourceData, m interface{}, timeout time.Duration) error {
+	id := d.Id()
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{transportNodeStatePending, transportNodeStateInProgress},
+		Target:  []string{transportNodeStateSuccess},
+		Refresh: func() (interface{}, string, error) {
+			var state manager.TransportNodeState
+			_, err := managerAPIRequest(m, http.MethodGet, "/transport-nodes/"+id+"/state", nil, &state)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error while querying Edge Transport Node %s state: %v", id, err)
+			}
+
+			log.Printf("[DEBUG] Edge Transport Node %s state: %s", id, state.State)
+			if state.State == transportNodeStatePending || state.State == transportNodeStateInProgress || state.State == transportNodeStateSuccess {
+				return state, state.State, nil
+			}
+
+			return state, state.State, fmt.Errorf("Edge Transport Node %s deployment is in state %s: %s", id, state.State, state.FailureMessage)
+		},
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceNsxtEdgeTransportNodeCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	node := getEdgeTransportNodeFromSchema(d)
+
+	log.Printf("[INFO] Creating Edge Transport Node %s", node.DisplayName)
+	var result edgeTransportNode
+	_, err := managerAPIRequest(m, http.MethodPost, "/transport-nodes", node, &result)
+	if err != nil {
+		return fmt.Errorf("Error during Edge Transport Node create: %v", err)
+	}
+
+	// ID is set before waiting, so that failed deployment is cleaned up
+	// when the tainted resource is destroyed
+	d.SetId(result.ID)
+	err = waitForEdgeTransportNodeDeployment(d, m, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtEdgeTransportNodeRead(d, m)
+}
+
+func resourceNsxtEdgeTransportNodeRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	var node edgeTransportNode
+	resp, err := managerAPIRequest(m, http.MethodGet, "/transport-nodes/"+id, nil, &node)
+	if isManagerAPINotFound(resp) {
+		log.Printf("[DEBUG] Edge Transport Node %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during Edge Transport Node read: %v", err)
+	}
+
+	d.Set("revision", node.Revision)
+	d.Set("description", node.Description)
+	d.Set("display_name", node.DisplayName)
+	setTagsInSchema(d, node.Tags)
+	err = setTransportNodeHostSwitchSpecInSchema(d, node.HostSwitchSpec, false)
+	if err != nil {
+		return fmt.Errorf("Error during Edge Transport Node host switches set in schema: %v", err)
+	}
+
+	// Credentials are not returned by NSX, and are kept from configuration
+	if info := node.NodeDeploymentInfo; info != nil {
+		d.Set("form_factor", info.DeploymentConfig.FormFactor)
+
+		vmConfig := info.DeploymentConfig.VMDeploymentConfig
+		elem := make(map[string]interface{})
+		elem["vc_id"] = vmConfig.VcID
+		elem["compute_id"] = vmConfig.ComputeID
+		elem["storage_id"] = vmConfig.StorageID
+		elem["host_id"] = vmConfig.HostID
+		elem["management_network_id"] = vmConfig.ManagementNetworkID
+		elem["data_network_ids"] = vmConfig.DataNetworkIDs
+		elem["default_gateway_addresses"] = vmConfig.DefaultGatewayAddresses
+		var subnets []map[string]interface{}
+		for _, subnet := range vmConfig.ManagementPortSubnets {
+			subnets = append(subnets, map[string]interface{}{
+				"ip_addresses":  subnet.IpAddresses,
+				"prefix_length": subnet.PrefixLength,
+			})
+		}
+		elem["management_port_subnet"] = subnets
+		d.Set("vm_deployment_config", []interface{}{elem})
+
+		settings := info.NodeSettings
+		settingsElem := make(map[string]interface{})
+		settingsElem["hostname"] = settings.Hostname
+		settingsElem["ntp_servers"] = settings.NtpServers
+		settingsElem["dns_servers"] = settings.DNSServers
+		settingsElem["search_domains"] = settings.SearchDomains
+		settingsElem["enable_ssh"] = settings.EnableSSH
+		settingsElem["allow_ssh_root_login"] = settings.AllowSSHRootLogin
+		d.Set("node_settings", []interface{}{settingsElem})
+	}
+
+	var state manager.TransportNodeState
+	_, err = managerAPIRequest(m, http.MethodGet, "/transport-nodes/"+id+"/state", nil, &state)
+	if err != nil {
+		return fmt.Errorf("Error during Edge Transport Node state read: %v", err)
+	}
+	d.Set("deployment_state", state.State)
+
+	return nil
+}
+
+func resourceNsxtEdgeTransportNodeUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	// Node user settings can only be set upon deployment
+	node := getEdgeTransportNodeFromSchema(d)
+	node.ID = id
+	node.NodeDeploymentInfo.ID = id
+	node.NodeDeploymentInfo.DeploymentConfig.NodeUserSettings = nil
+
+	log.Printf("[INFO] Updating Edge Transport Node %s", id)
+	_, err := managerAPIRequest(m, http.MethodPut, "/transport-nodes/"+id, node, nil)
+	if err != nil {
+		return fmt.Errorf("Error during Edge Transport Node update: %v", err)
+	}
+
+	err = waitForEdgeTransportNodeDeployment(d, m, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtEdgeTransportNodeRead(d, m)
+}
+
+func resourceNsxtEdgeTransportNodeDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := managerAPIRequest(m, http.MethodDelete, "/transport-nodes/"+id, nil, nil)
+	if isManagerAPINotFound(resp) {
+		log.Printf("[DEBUG] Edge Transport Node %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during Edge Transport Node delete: %v", err)
+	}
+
+	// Edge VM is removed asynchronously
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"exists"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := managerAPIRequest(m, http.MethodGet, "/transport-nodes/"+id, nil, nil)
+			if isManagerAPINotFound(resp) {
+				return id, "deleted", nil
+			}
+			if err != nil {
+				return nil, "", fmt.Errorf("Error while waiting for Edge Transport Node %s removal: %v", id, err)
+			}
+			return id, "exists", nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
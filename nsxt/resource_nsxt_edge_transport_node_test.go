@@ -0,0 +1,152 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccNsxtEdgeTransportNodePreCheck(t *testing.T) {
+	testAccOnlyLocalManager(t)
+	testAccTestMP(t)
+	testAccPreCheck(t)
+	testAccEnvDefined(t, "NSXT_TEST_COMPUTE_MANAGER_ID")
+	testAccEnvDefined(t, "NSXT_TEST_EDGE_COMPUTE_ID")
+	testAccEnvDefined(t, "NSXT_TEST_EDGE_STORAGE_ID")
+	testAccEnvDefined(t, "NSXT_TEST_EDGE_MANAGEMENT_NETWORK_ID")
+	testAccEnvDefined(t, "NSXT_TEST_EDGE_DATA_NETWORK_ID")
+	testAccEnvDefined(t, "NSXT_TEST_EDGE_UPLINK_PROFILE_ID")
+}
+
+func TestAccResourceNsxtEdgeTransportNode_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_edge_transport_node.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccNsxtEdgeTransportNodePreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtEdgeTransportNodeCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtEdgeTransportNodeTemplate(name, "Acceptance Test", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtEdgeTransportNodeExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "form_factor", "MEDIUM"),
+					resource.TestCheckResourceAttr(testResourceName, "node_settings.0.enable_ssh", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "deployment_state", "success"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtEdgeTransportNodeTemplate(name, "Acceptance Test Update", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtEdgeTransportNodeExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "node_settings.0.enable_ssh", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "deployment_state", "success"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtEdgeTransportNodeExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Edge Transport Node resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Edge Transport Node resource ID not set in resources")
+		}
+
+		_, err := managerAPIRequest(testAccProvider.Meta(), http.MethodGet, "/transport-nodes/"+resourceID, nil, nil)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Edge Transport Node %s: %v", resourceID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtEdgeTransportNodeCheckDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_edge_transport_node" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		resp, err := managerAPIRequest(testAccProvider.Meta(), http.MethodGet, "/transport-nodes/"+resourceID, nil, nil)
+		if isManagerAPINotFound(resp) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Edge Transport Node %s: %v", resourceID, err)
+		}
+
+		return fmt.Errorf("Edge Transport Node %s still exists", resourceID)
+	}
+	return nil
+}
+
+func testAccNsxtEdgeTransportNodeTemplate(name string, description string, enableSSH bool) string {
+	return fmt.Sprintf(`
+data "nsxt_transport_zone" "overlay" {
+  display_name = "%s"
+}
+
+resource "nsxt_edge_transport_node" "test" {
+  display_name = "%s"
+  description  = "%s"
+
+  credentials {
+    cli_password  = "Terraform-Test-Password1!"
+    root_password = "Terraform-Test-Password1!"
+  }
+
+  vm_deployment_config {
+    vc_id                 = "%s"
+    compute_id            = "%s"
+    storage_id            = "%s"
+    management_network_id = "%s"
+    data_network_ids      = ["%s"]
+  }
+
+  node_settings {
+    hostname   = "%s.test.local"
+    enable_ssh = %t
+  }
+
+  host_switch {
+    uplink_profile_id  = "%s"
+    transport_zone_ids = [data.nsxt_transport_zone.overlay.id]
+
+    pnic {
+      device_name = "fp-eth0"
+      uplink_name = "uplink-1"
+    }
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, getOverlayTransportZoneName(), name, description, os.Getenv("NSXT_TEST_COMPUTE_MANAGER_ID"),
+		os.Getenv("NSXT_TEST_EDGE_COMPUTE_ID"), os.Getenv("NSXT_TEST_EDGE_STORAGE_ID"),
+		os.Getenv("NSXT_TEST_EDGE_MANAGEMENT_NETWORK_ID"), os.Getenv("NSXT_TEST_EDGE_DATA_NETWORK_ID"),
+		name, enableSSH, os.Getenv("NSXT_TEST_EDGE_UPLINK_PROFILE_ID"))
+}
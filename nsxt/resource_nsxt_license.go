@@ -0,0 +1,141 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/licensing"
+)
+
+func resourceNsxtLicense() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtLicenseCreate,
+		Read:   resourceNsxtLicenseRead,
+		Delete: resourceNsxtLicenseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"license_key": {
+				Type:         schema.TypeString,
+				Description:  "License key",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLicenseKey(),
+			},
+			"edition":         getLicenseAttributeSchema(schema.TypeString, "License edition"),
+			"capacity_type":   getLicenseAttributeSchema(schema.TypeString, "License metric"),
+			"quantity":        getLicenseAttributeSchema(schema.TypeInt, "License capacity, 0 for unlimited"),
+			"expiry":          getLicenseAttributeSchema(schema.TypeString, "License expiry date"),
+			"is_eval":         getLicenseAttributeSchema(schema.TypeBool, "Whether this is an evaluation license"),
+			"is_expired":      getLicenseAttributeSchema(schema.TypeBool, "Whether the license has expired"),
+			"product_name":    getLicenseAttributeSchema(schema.TypeString, "Product name"),
+			"product_version": getLicenseAttributeSchema(schema.TypeString, "Product version"),
+			"features":        getLicenseAttributeSchema(schema.TypeString, "Semicolon delimited feature list"),
+		},
+	}
+}
+
+func getLicenseAttributeSchema(attrType schema.ValueType, description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        attrType,
+		Description: description,
+		Computed:    true,
+	}
+}
+
+func getLicenseAttributes(license licensing.License) map[string]interface{} {
+	attrs := make(map[string]interface{})
+	attrs["edition"] = license.Description
+	attrs["capacity_type"] = license.CapacityType
+	attrs["quantity"] = license.Quantity
+	attrs["expiry"] = ""
+	if license.Expiry > 0 {
+		attrs["expiry"] = formatEpochMillisTime(&license.Expiry)
+	}
+	attrs["is_eval"] = license.IsEval
+	attrs["is_expired"] = license.IsExpired
+	attrs["product_name"] = license.ProductName
+	attrs["product_version"] = license.ProductVersion
+	attrs["features"] = license.Features
+
+	return attrs
+}
+
+func resourceNsxtLicenseCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	licenseKey := d.Get("license_key").(string)
+	log.Printf("[INFO] Applying license")
+	err := applyLicense(nsxClient, licenseKey)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(licenseKey)
+
+	return resourceNsxtLicenseRead(d, m)
+}
+
+func resourceNsxtLicenseRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining license key")
+	}
+
+	license, resp, err := nsxClient.LicensingApi.GetLicenseByKey(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] License not found")
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during license read: %v", err)
+	}
+
+	d.Set("license_key", license.LicenseKey)
+	for key, value := range getLicenseAttributes(license) {
+		d.Set(key, value)
+	}
+
+	return nil
+}
+
+func resourceNsxtLicenseDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining license key")
+	}
+
+	license := licensing.License{LicenseKey: id}
+	resp, err := nsxClient.LicensingApi.DeleteLicenseKeyDelete(nsxClient.Context, license)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] License not found")
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during license delete: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtLicense_basic(t *testing.T) {
+	testResourceName := "nsxt_license.test"
+	licenseKey := os.Getenv("NSXT_TEST_LICENSE_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccTestMP(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_LICENSE_KEY")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXLicenseCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLicenseCreateTemplate(licenseKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXLicenseExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "license_key", licenseKey),
+					resource.TestCheckResourceAttrSet(testResourceName, "edition"),
+					resource.TestCheckResourceAttrSet(testResourceName, "capacity_type"),
+					resource.TestCheckResourceAttr(testResourceName, "is_expired", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtLicense_importBasic(t *testing.T) {
+	testResourceName := "nsxt_license.test"
+	licenseKey := os.Getenv("NSXT_TEST_LICENSE_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccTestMP(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_LICENSE_KEY")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXLicenseCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXLicenseCreateTemplate(licenseKey),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXLicenseExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("License resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("License resource ID not set in resources")
+		}
+
+		_, responseCode, err := nsxClient.LicensingApi.GetLicenseByKey(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving license. Error: %v", err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if license exists. HTTP return code was %d", responseCode.StatusCode)
+		}
+
+		return nil
+	}
+}
+
+func testAccNSXLicenseCheckDestroy(state *terraform.State) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_license" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		_, responseCode, err := nsxClient.LicensingApi.GetLicenseByKey(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode != nil && responseCode.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving license. Error: %v", err)
+		}
+
+		return fmt.Errorf("License still exists")
+	}
+	return nil
+}
+
+func testAccNSXLicenseCreateTemplate(licenseKey string) string {
+	return fmt.Sprintf(`
+resource "nsxt_license" "test" {
+  license_key = "%s"
+}`, licenseKey)
+}
@@ -0,0 +1,124 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/common"
+)
+
+const lldpHostSwitchProfileName = "LLDP Host Switch Profile"
+
+// go-vmware-nsxt has no LLDP host switch profile model
+type lldpHostSwitchProfile struct {
+	Revision     int64        `json:"_revision"`
+	ResourceType string       `json:"resource_type"`
+	DisplayName  string       `json:"display_name,omitempty"`
+	Description  string       `json:"description"`
+	Tags         []common.Tag `json:"tags"`
+	SendEnabled  bool         `json:"send_enabled"`
+}
+
+func resourceNsxtLldpHostSwitchProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtLldpHostSwitchProfileCreate,
+		Read:   resourceNsxtLldpHostSwitchProfileRead,
+		Update: resourceNsxtLldpHostSwitchProfileUpdate,
+		Delete: resourceNsxtLldpHostSwitchProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"send_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable sending of LLDP packets",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func getLldpHostSwitchProfileFromSchema(d *schema.ResourceData) lldpHostSwitchProfile {
+	return lldpHostSwitchProfile{
+		Revision:     int64(d.Get("revision").(int)),
+		ResourceType: transportNodeLldpProfileType,
+		DisplayName:  d.Get("display_name").(string),
+		Description:  d.Get("description").(string),
+		Tags:         getTagsFromSchema(d),
+		SendEnabled:  d.Get("send_enabled").(bool),
+	}
+}
+
+func resourceNsxtLldpHostSwitchProfileCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	err := createHostSwitchProfile(d, m, lldpHostSwitchProfileName, getLldpHostSwitchProfileFromSchema(d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtLldpHostSwitchProfileRead(d, m)
+}
+
+func resourceNsxtLldpHostSwitchProfileRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	var profile lldpHostSwitchProfile
+	found, err := readHostSwitchProfile(d, m, lldpHostSwitchProfileName, &profile)
+	if err != nil || !found {
+		return err
+	}
+
+	d.Set("revision", profile.Revision)
+	d.Set("description", profile.Description)
+	d.Set("display_name", profile.DisplayName)
+	setTagsInSchema(d, profile.Tags)
+	d.Set("send_enabled", profile.SendEnabled)
+
+	return nil
+}
+
+func resourceNsxtLldpHostSwitchProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	err := updateHostSwitchProfile(d, m, lldpHostSwitchProfileName, getLldpHostSwitchProfileFromSchema(d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtLldpHostSwitchProfileRead(d, m)
+}
+
+func resourceNsxtLldpHostSwitchProfileDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	return deleteHostSwitchProfile(d, m, lldpHostSwitchProfileName)
+}
@@ -0,0 +1,83 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtLldpHostSwitchProfile_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_lldp_host_switch_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtHostSwitchProfileCheckDestroy(state, "nsxt_lldp_host_switch_profile")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtLldpHostSwitchProfileTemplate(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtHostSwitchProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "send_enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtLldpHostSwitchProfileTemplate(updateName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtHostSwitchProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "send_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtLldpHostSwitchProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_lldp_host_switch_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtHostSwitchProfileCheckDestroy(state, "nsxt_lldp_host_switch_profile")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtLldpHostSwitchProfileTemplate(name, true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtLldpHostSwitchProfileTemplate(name string, sendEnabled bool) string {
+	return fmt.Sprintf(`
+resource "nsxt_lldp_host_switch_profile" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  send_enabled = %t
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, sendEnabled)
+}
@@ -0,0 +1,124 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/administration"
+)
+
+func resourceNsxtManagerNodeDNSConfig() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNsxtManagerNodeDNSConfigCreate,
+		Read:     resourceNsxtManagerNodeDNSConfigRead,
+		Update:   resourceNsxtManagerNodeDNSConfigUpdate,
+		Delete:   resourceNsxtManagerNodeDNSConfigDelete,
+		Importer: getManagerNodeImporter(false),
+
+		Schema: map[string]*schema.Schema{
+			"name_servers": {
+				Type:        schema.TypeList,
+				Description: "Name servers",
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    3,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateSingleIP(),
+				},
+			},
+			"search_domains": {
+				Type:        schema.TypeList,
+				Description: "Search domains",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"transport_node_id": getManagerNodeTransportNodeIDSchema(),
+		},
+	}
+}
+
+func managerNodeDNSConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	nameServers := administration.NodeNameServersProperties{
+		NameServers: interface2StringList(d.Get("name_servers").([]interface{})),
+	}
+	_, err := managerAPIRequest(m, http.MethodPut, getManagerNodeAPIPath(d, "/node/network/name-servers"), nameServers, nil)
+	if err != nil {
+		return err
+	}
+
+	searchDomains := administration.NodeSearchDomainsProperties{
+		SearchDomains: interface2StringList(d.Get("search_domains").([]interface{})),
+	}
+	_, err = managerAPIRequest(m, http.MethodPut, getManagerNodeAPIPath(d, "/node/network/search-domains"), searchDomains, nil)
+	return err
+}
+
+func resourceNsxtManagerNodeDNSConfigCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	log.Printf("[INFO] Configuring Manager Node DNS")
+	err := managerNodeDNSConfigUpdate(d, m)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node DNS config create: %v", err)
+	}
+
+	d.SetId(newUUID())
+
+	return resourceNsxtManagerNodeDNSConfigRead(d, m)
+}
+
+func resourceNsxtManagerNodeDNSConfigRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	var nameServers administration.NodeNameServersProperties
+	_, err := managerAPIRequest(m, http.MethodGet, getManagerNodeAPIPath(d, "/node/network/name-servers"), nil, &nameServers)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node name servers read: %v", err)
+	}
+
+	var searchDomains administration.NodeSearchDomainsProperties
+	_, err = managerAPIRequest(m, http.MethodGet, getManagerNodeAPIPath(d, "/node/network/search-domains"), nil, &searchDomains)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node search domains read: %v", err)
+	}
+
+	d.Set("name_servers", nameServers.NameServers)
+	d.Set("search_domains", searchDomains.SearchDomains)
+
+	return nil
+}
+
+func resourceNsxtManagerNodeDNSConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	log.Printf("[INFO] Updating Manager Node DNS config")
+	err := managerNodeDNSConfigUpdate(d, m)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node DNS config update: %v", err)
+	}
+
+	return resourceNsxtManagerNodeDNSConfigRead(d, m)
+}
+
+func resourceNsxtManagerNodeDNSConfigDelete(d *schema.ResourceData, m interface{}) error {
+	// Removing name servers would break name resolution on the node,
+	// hence no action is taken here and configuration remains as is.
+	return nil
+}
@@ -0,0 +1,55 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtManagerNodeDNSConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_manager_node_dns_config.test"
+	nameServer := os.Getenv("NSXT_TEST_DNS_SERVER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccTestMP(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_DNS_SERVER")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtManagerNodeDNSConfigTemplate(nameServer, "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "name_servers.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "name_servers.0", nameServer),
+					resource.TestCheckResourceAttr(testResourceName, "search_domains.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "search_domains.0", "example.com"),
+				),
+			},
+			{
+				Config: testAccNsxtManagerNodeDNSConfigTemplate(nameServer, "example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "name_servers.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "name_servers.0", nameServer),
+					resource.TestCheckResourceAttr(testResourceName, "search_domains.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "search_domains.0", "example.org"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtManagerNodeDNSConfigTemplate(nameServer string, searchDomain string) string {
+	return fmt.Sprintf(`
+resource "nsxt_manager_node_dns_config" "test" {
+  name_servers   = ["%s"]
+  search_domains = ["%s"]
+}`, nameServer, searchDomain)
+}
@@ -0,0 +1,116 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/administration"
+)
+
+func resourceNsxtManagerNodeNtpConfig() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNsxtManagerNodeNtpConfigCreate,
+		Read:     resourceNsxtManagerNodeNtpConfigRead,
+		Update:   resourceNsxtManagerNodeNtpConfigUpdate,
+		Delete:   resourceNsxtManagerNodeNtpConfigDelete,
+		Importer: getManagerNodeImporter(false),
+
+		Schema: map[string]*schema.Schema{
+			"servers": {
+				Type:        schema.TypeList,
+				Description: "NTP servers",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"enabled":           getManagerNodeServiceEnabledSchema("NTP"),
+			"transport_node_id": getManagerNodeTransportNodeIDSchema(),
+		},
+	}
+}
+
+func managerNodeNtpConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	ntpConfig := administration.NodeNtpServiceProperties{
+		ServiceName: "ntp",
+		ServiceProperties: &administration.NtpServiceProperties{
+			Servers: interface2StringList(d.Get("servers").([]interface{})),
+		},
+	}
+
+	_, err := managerAPIRequest(m, http.MethodPut, getManagerNodeAPIPath(d, "/node/services/ntp"), ntpConfig, nil)
+	if err != nil {
+		return err
+	}
+
+	return updateManagerNodeServiceState(d, m, "NTP", "ntp", d.Get("enabled").(bool))
+}
+
+func resourceNsxtManagerNodeNtpConfigCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	log.Printf("[INFO] Configuring Manager Node NTP")
+	err := managerNodeNtpConfigUpdate(d, m)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node NTP config create: %v", err)
+	}
+
+	d.SetId(newUUID())
+
+	return resourceNsxtManagerNodeNtpConfigRead(d, m)
+}
+
+func resourceNsxtManagerNodeNtpConfigRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	var ntpConfig administration.NodeNtpServiceProperties
+	_, err := managerAPIRequest(m, http.MethodGet, getManagerNodeAPIPath(d, "/node/services/ntp"), nil, &ntpConfig)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node NTP config read: %v", err)
+	}
+
+	if ntpConfig.ServiceProperties != nil {
+		d.Set("servers", ntpConfig.ServiceProperties.Servers)
+	}
+
+	running, err := isManagerNodeServiceRunning(d, m, "ntp")
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node NTP status read: %v", err)
+	}
+	d.Set("enabled", running)
+
+	return nil
+}
+
+func resourceNsxtManagerNodeNtpConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	log.Printf("[INFO] Updating Manager Node NTP config")
+	err := managerNodeNtpConfigUpdate(d, m)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node NTP config update: %v", err)
+	}
+
+	return resourceNsxtManagerNodeNtpConfigRead(d, m)
+}
+
+func resourceNsxtManagerNodeNtpConfigDelete(d *schema.ResourceData, m interface{}) error {
+	// Removing NTP servers would leave the node without time synchronization,
+	// hence no action is taken here and configuration remains as is.
+	return nil
+}
@@ -0,0 +1,56 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtManagerNodeNtpConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_manager_node_ntp_config.test"
+	ntpServer := os.Getenv("NSXT_TEST_NTP_SERVER")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccTestMP(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_NTP_SERVER")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtManagerNodeNtpConfigTemplate(ntpServer, "time.google.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "servers.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "servers.0", ntpServer),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccNsxtManagerNodeNtpConfigTemplate(ntpServer, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "servers.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "servers.0", ntpServer),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtManagerNodeNtpConfigTemplate(server string, extraServer string) string {
+	servers := fmt.Sprintf("\"%s\"", server)
+	if extraServer != "" {
+		servers = fmt.Sprintf("%s, \"%s\"", servers, extraServer)
+	}
+	return fmt.Sprintf(`
+resource "nsxt_manager_node_ntp_config" "test" {
+  servers = [%s]
+}`, servers)
+}
@@ -0,0 +1,215 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vmware-nsxt/administration"
+)
+
+var managerNodeSnmpTargetKeys = []string{"community", "v3_user"}
+
+func resourceNsxtManagerNodeSnmpConfig() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNsxtManagerNodeSnmpConfigCreate,
+		Read:     resourceNsxtManagerNodeSnmpConfigRead,
+		Update:   resourceNsxtManagerNodeSnmpConfigUpdate,
+		Delete:   resourceNsxtManagerNodeSnmpConfigDelete,
+		Importer: getManagerNodeImporter(false),
+
+		Schema: map[string]*schema.Schema{
+			"community": {
+				Type:         schema.TypeList,
+				Description:  "SNMP v2c communities",
+				Optional:     true,
+				AtLeastOneOf: managerNodeSnmpTargetKeys,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"community_string": {
+							Type:         schema.TypeString,
+							Description:  "Community string",
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"access": {
+							Type:         schema.TypeString,
+							Description:  "Type of access",
+							Optional:     true,
+							Default:      "read_only",
+							ValidateFunc: validation.StringInSlice([]string{"read_only"}, false),
+						},
+					},
+				},
+			},
+			"v3_user": {
+				Type:         schema.TypeList,
+				Description:  "SNMP v3 users",
+				Optional:     true,
+				AtLeastOneOf: managerNodeSnmpTargetKeys,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:         schema.TypeString,
+							Description:  "User ID",
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 32),
+						},
+						"auth_password": {
+							Type:         schema.TypeString,
+							Description:  "Authentication password",
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(8, 128),
+						},
+						"priv_password": {
+							Type:         schema.TypeString,
+							Description:  "Privacy password",
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(8, 128),
+						},
+					},
+				},
+			},
+			"start_on_boot": {
+				Type:        schema.TypeBool,
+				Description: "Flag to start SNMP service on boot",
+				Optional:    true,
+				Default:     true,
+			},
+			"enabled":           getManagerNodeServiceEnabledSchema("SNMP"),
+			"transport_node_id": getManagerNodeTransportNodeIDSchema(),
+		},
+	}
+}
+
+// SNMP v3 users are not part of go-vmware-nsxt model, hence the payload
+// is built as a map
+func getManagerNodeSnmpConfigFromSchema(d *schema.ResourceData) map[string]interface{} {
+	var communities []map[string]interface{}
+	for _, community := range d.Get("community").([]interface{}) {
+		data := community.(map[string]interface{})
+		communities = append(communities, map[string]interface{}{
+			"community_string": data["community_string"].(string),
+			"access":           data["access"].(string),
+		})
+	}
+
+	var users []map[string]interface{}
+	for _, user := range d.Get("v3_user").([]interface{}) {
+		data := user.(map[string]interface{})
+		users = append(users, map[string]interface{}{
+			"user_id":       data["user_id"].(string),
+			"auth_password": data["auth_password"].(string),
+			"priv_password": data["priv_password"].(string),
+		})
+	}
+
+	properties := map[string]interface{}{
+		"start_on_boot": d.Get("start_on_boot").(bool),
+	}
+	if len(communities) > 0 {
+		properties["communities"] = communities
+	}
+	if len(users) > 0 {
+		properties["v3_users"] = users
+	}
+
+	return map[string]interface{}{
+		"service_name":       "snmp",
+		"service_properties": properties,
+	}
+}
+
+func managerNodeSnmpConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	_, err := managerAPIRequest(m, http.MethodPut, getManagerNodeAPIPath(d, "/node/services/snmp"), getManagerNodeSnmpConfigFromSchema(d), nil)
+	if err != nil {
+		return err
+	}
+
+	return updateManagerNodeServiceState(d, m, "SNMP", "snmp", d.Get("enabled").(bool))
+}
+
+func resourceNsxtManagerNodeSnmpConfigCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	log.Printf("[INFO] Configuring Manager Node SNMP")
+	err := managerNodeSnmpConfigUpdate(d, m)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node SNMP config create: %v", err)
+	}
+
+	d.SetId(newUUID())
+
+	return resourceNsxtManagerNodeSnmpConfigRead(d, m)
+}
+
+func resourceNsxtManagerNodeSnmpConfigRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	// NOTE: SNMP communities, v3 users and start on boot flag are not
+	// returned by NSX, hence only service running state is read back
+	running, err := isManagerNodeServiceRunning(d, m, "snmp")
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node SNMP status read: %v", err)
+	}
+	d.Set("enabled", running)
+
+	return nil
+}
+
+func resourceNsxtManagerNodeSnmpConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	log.Printf("[INFO] Updating Manager Node SNMP config")
+	err := managerNodeSnmpConfigUpdate(d, m)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node SNMP config update: %v", err)
+	}
+
+	return resourceNsxtManagerNodeSnmpConfigRead(d, m)
+}
+
+func resourceNsxtManagerNodeSnmpConfigDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	// Communities can not be cleared with this API, hence SNMP service is
+	// stopped and is not started on boot
+	snmpConfig := administration.NodeSnmpServiceProperties{
+		ServiceName: "snmp",
+		ServiceProperties: &administration.SnmpServiceProperties{
+			StartOnBoot: false,
+		},
+	}
+
+	_, err := managerAPIRequest(m, http.MethodPut, getManagerNodeAPIPath(d, "/node/services/snmp"), snmpConfig, nil)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node SNMP config delete: %v", err)
+	}
+
+	err = updateManagerNodeServiceState(d, m, "SNMP", "snmp", false)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node SNMP config delete: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtManagerNodeSnmpConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_manager_node_snmp_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtManagerNodeSnmpConfigTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "community.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "community.0.access", "read_only"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccNsxtManagerNodeSnmpConfigTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "community.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccNsxtManagerNodeSnmpConfigV3Template(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "community.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "v3_user.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "v3_user.0.user_id", "tfuser"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtManagerNodeSnmpConfigTemplate(enabled bool) string {
+	return fmt.Sprintf(`
+resource "nsxt_manager_node_snmp_config" "test" {
+  community {
+    community_string = "tf-test-community"
+  }
+
+  enabled = %t
+}`, enabled)
+}
+
+func testAccNsxtManagerNodeSnmpConfigV3Template() string {
+	return `
+resource "nsxt_manager_node_snmp_config" "test" {
+  v3_user {
+    user_id       = "tfuser"
+    auth_password = "tf-test-auth-1"
+    priv_password = "tf-test-priv-1"
+  }
+}`
+}
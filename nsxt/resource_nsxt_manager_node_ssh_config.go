@@ -0,0 +1,113 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/administration"
+)
+
+func resourceNsxtManagerNodeSSHConfig() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNsxtManagerNodeSSHConfigCreate,
+		Read:     resourceNsxtManagerNodeSSHConfigRead,
+		Update:   resourceNsxtManagerNodeSSHConfigUpdate,
+		Delete:   resourceNsxtManagerNodeSSHConfigDelete,
+		Importer: getManagerNodeImporter(false),
+
+		Schema: map[string]*schema.Schema{
+			"start_on_boot": {
+				Type:        schema.TypeBool,
+				Description: "Flag to start SSH service on boot",
+				Optional:    true,
+				Default:     false,
+			},
+			"enabled":           getManagerNodeServiceEnabledSchema("SSH"),
+			"transport_node_id": getManagerNodeTransportNodeIDSchema(),
+		},
+	}
+}
+
+func managerNodeSSHConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	sshConfig := administration.NodeSshServiceProperties{
+		ServiceName: "ssh",
+		ServiceProperties: &administration.SshServiceProperties{
+			StartOnBoot: d.Get("start_on_boot").(bool),
+		},
+	}
+
+	_, err := managerAPIRequest(m, http.MethodPut, getManagerNodeAPIPath(d, "/node/services/ssh"), sshConfig, nil)
+	if err != nil {
+		return err
+	}
+
+	return updateManagerNodeServiceState(d, m, "SSH", "ssh", d.Get("enabled").(bool))
+}
+
+func resourceNsxtManagerNodeSSHConfigCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	log.Printf("[INFO] Configuring Manager Node SSH")
+	err := managerNodeSSHConfigUpdate(d, m)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node SSH config create: %v", err)
+	}
+
+	d.SetId(newUUID())
+
+	return resourceNsxtManagerNodeSSHConfigRead(d, m)
+}
+
+func resourceNsxtManagerNodeSSHConfigRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	var sshConfig administration.NodeSshServiceProperties
+	_, err := managerAPIRequest(m, http.MethodGet, getManagerNodeAPIPath(d, "/node/services/ssh"), nil, &sshConfig)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node SSH config read: %v", err)
+	}
+
+	if sshConfig.ServiceProperties != nil {
+		d.Set("start_on_boot", sshConfig.ServiceProperties.StartOnBoot)
+	}
+
+	running, err := isManagerNodeServiceRunning(d, m, "ssh")
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node SSH status read: %v", err)
+	}
+	d.Set("enabled", running)
+
+	return nil
+}
+
+func resourceNsxtManagerNodeSSHConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	log.Printf("[INFO] Updating Manager Node SSH config")
+	err := managerNodeSSHConfigUpdate(d, m)
+	if err != nil {
+		return fmt.Errorf("Error during Manager Node SSH config update: %v", err)
+	}
+
+	return resourceNsxtManagerNodeSSHConfigRead(d, m)
+}
+
+func resourceNsxtManagerNodeSSHConfigDelete(d *schema.ResourceData, m interface{}) error {
+	// SSH service state is left as configured, since changing it on
+	// destroy might unexpectedly expose or lock out the node.
+	return nil
+}
@@ -0,0 +1,44 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtManagerNodeSSHConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_manager_node_ssh_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtManagerNodeSSHConfigTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "start_on_boot", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccNsxtManagerNodeSSHConfigTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "start_on_boot", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtManagerNodeSSHConfigTemplate(startOnBoot bool) string {
+	return fmt.Sprintf(`
+resource "nsxt_manager_node_ssh_config" "test" {
+  start_on_boot = %t
+  enabled       = true
+}`, startOnBoot)
+}
@@ -0,0 +1,195 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vmware-nsxt/administration"
+)
+
+var managerNodeSyslogExporterProtocolValues = []string{"TCP", "TLS", "UDP", "LI", "LI-TLS"}
+var managerNodeSyslogExporterLevelValues = []string{"EMERG", "ALERT", "CRIT", "ERR", "WARNING", "NOTICE", "INFO", "DEBUG"}
+
+func resourceNsxtManagerNodeSyslogExporter() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNsxtManagerNodeSyslogExporterCreate,
+		Read:     resourceNsxtManagerNodeSyslogExporterRead,
+		Delete:   resourceNsxtManagerNodeSyslogExporterDelete,
+		Importer: getManagerNodeImporter(true),
+
+		// NOTE: syslog exporters can not be updated in NSX, hence all
+		// attributes force new resource
+		Schema: map[string]*schema.Schema{
+			"exporter_name": {
+				Type:        schema.TypeString,
+				Description: "Syslog exporter name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"server": {
+				Type:        schema.TypeString,
+				Description: "IP address or hostname of server to export to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Description:  "Port to export to",
+				Optional:     true,
+				Default:      514,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Description:  "Export protocol",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managerNodeSyslogExporterProtocolValues, false),
+			},
+			"level": {
+				Type:         schema.TypeString,
+				Description:  "Logging level to export",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managerNodeSyslogExporterLevelValues, false),
+			},
+			"facilities": {
+				Type:        schema.TypeSet,
+				Description: "Facilities to export",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"msgids": {
+				Type:        schema.TypeSet,
+				Description: "MSGIDs to export",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"structured_data": {
+				Type:        schema.TypeSet,
+				Description: "Structured data to export",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tls_ca_pem": {
+				Type:        schema.TypeString,
+				Description: "CA certificate PEM of TLS server to export to",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"transport_node_id": getManagerNodeTransportNodeIDSchema(),
+		},
+	}
+}
+
+func resourceNsxtManagerNodeSyslogExporterCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	exporter := administration.NodeSyslogExporterProperties{
+		ExporterName:   d.Get("exporter_name").(string),
+		Server:         d.Get("server").(string),
+		Port:           int64(d.Get("port").(int)),
+		Protocol:       d.Get("protocol").(string),
+		Level:          d.Get("level").(string),
+		Facilities:     getStringListFromSchemaSet(d, "facilities"),
+		Msgids:         getStringListFromSchemaSet(d, "msgids"),
+		StructuredData: getStringListFromSchemaSet(d, "structured_data"),
+		TlsCaPem:       d.Get("tls_ca_pem").(string),
+	}
+
+	log.Printf("[INFO] Creating Manager Node Syslog Exporter %s", exporter.ExporterName)
+	resp, err := managerAPIRequest(m, http.MethodPost, getManagerNodeAPIPath(d, "/node/services/syslog/exporters"), exporter, &exporter)
+	if err != nil {
+		return fmt.Errorf("Error during Syslog Exporter create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Unexpected status returned during Syslog Exporter create: %v", resp.StatusCode)
+	}
+	d.SetId(exporter.ExporterName)
+
+	return resourceNsxtManagerNodeSyslogExporterRead(d, m)
+}
+
+func resourceNsxtManagerNodeSyslogExporterRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Syslog Exporter name")
+	}
+
+	var exporter administration.NodeSyslogExporterProperties
+	resp, err := managerAPIRequest(m, http.MethodGet, getManagerNodeAPIPath(d, "/node/services/syslog/exporters/"+id), nil, &exporter)
+	if isManagerAPINotFound(resp) {
+		log.Printf("[DEBUG] Syslog Exporter %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during Syslog Exporter read: %v", err)
+	}
+
+	d.Set("exporter_name", exporter.ExporterName)
+	d.Set("server", exporter.Server)
+	d.Set("port", exporter.Port)
+	d.Set("protocol", exporter.Protocol)
+	d.Set("level", exporter.Level)
+	d.Set("facilities", exporter.Facilities)
+	d.Set("msgids", exporter.Msgids)
+	d.Set("structured_data", exporter.StructuredData)
+
+	// PEM is only set when missing from state (on import), to avoid
+	// replacing the exporter due to PEM formatting
+	if d.Get("tls_ca_pem").(string) == "" {
+		d.Set("tls_ca_pem", exporter.TlsCaPem)
+	}
+
+	return nil
+}
+
+func resourceNsxtManagerNodeSyslogExporterDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Syslog Exporter name")
+	}
+
+	resp, err := managerAPIRequest(m, http.MethodDelete, getManagerNodeAPIPath(d, "/node/services/syslog/exporters/"+id), nil, nil)
+	if isManagerAPINotFound(resp) {
+		log.Printf("[DEBUG] Syslog Exporter %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during Syslog Exporter delete: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtManagerNodeSyslogExporter_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_manager_node_syslog_exporter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtManagerNodeSyslogExporterCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtManagerNodeSyslogExporterTemplate(name, "INFO"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "exporter_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "server", "192.168.240.10"),
+					resource.TestCheckResourceAttr(testResourceName, "port", "514"),
+					resource.TestCheckResourceAttr(testResourceName, "protocol", "UDP"),
+					resource.TestCheckResourceAttr(testResourceName, "level", "INFO"),
+					resource.TestCheckResourceAttr(testResourceName, "facilities.#", "2"),
+				),
+			},
+			{
+				Config: testAccNsxtManagerNodeSyslogExporterTemplate(name, "WARNING"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "exporter_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "level", "WARNING"),
+					resource.TestCheckResourceAttr(testResourceName, "facilities.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtManagerNodeSyslogExporter_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_manager_node_syslog_exporter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtManagerNodeSyslogExporterCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtManagerNodeSyslogExporterTemplate(name, "INFO"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtManagerNodeSyslogExporterCheckDestroy(state *terraform.State) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_manager_node_syslog_exporter" {
+			continue
+		}
+
+		resourceID := rs.Primary.ID
+		_, responseCode, err := nsxClient.NsxComponentAdministrationApi.ReadNodeSyslogExporter(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode != nil && responseCode.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving Syslog Exporter %s. Error: %v", resourceID, err)
+		}
+
+		return fmt.Errorf("Syslog Exporter %s still exists", resourceID)
+	}
+	return nil
+}
+
+func testAccNsxtManagerNodeSyslogExporterTemplate(name string, level string) string {
+	return fmt.Sprintf(`
+resource "nsxt_manager_node_syslog_exporter" "test" {
+  exporter_name = "%s"
+  server        = "192.168.240.10"
+  protocol      = "UDP"
+  level         = "%s"
+  facilities    = ["AUTH", "SYSLOG"]
+}`, name, level)
+}
@@ -0,0 +1,205 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	mpToPolicyPromotionInProgress = "IN_PROGRESS"
+	mpToPolicyPromotionNotStarted = "NOT_STARTED"
+	mpToPolicyPromotionSuccess    = "SUCCESS"
+)
+
+// Policy path of promoted object is resolved with the same patterns
+// importers of the corresponding policy resources use
+var mpToPolicyPromotionPathPatterns = map[string]*regexp.Regexp{
+	"LOGICAL_SWITCH":   policySegmentPathPattern,
+	"NS_GROUP":         policyGroupPathPattern,
+	"FIREWALL_SECTION": policySecurityPolicyPathPattern,
+	"LOGICAL_ROUTER":   policyTier1GatewayPathPattern,
+}
+
+var mpToPolicyPromotionTypeValues = []string{"LOGICAL_SWITCH", "NS_GROUP", "FIREWALL_SECTION", "LOGICAL_ROUTER"}
+
+type mpToPolicyResourceID struct {
+	ManagerID string `json:"manager_id"`
+	PolicyID  string `json:"policy_id,omitempty"`
+}
+
+type mpToPolicyMigrationData struct {
+	Type        string                 `json:"type"`
+	ResourceIDs []mpToPolicyResourceID `json:"resource_ids"`
+}
+
+type mpToPolicyPromotionRequest struct {
+	MigrationData []mpToPolicyMigrationData `json:"migration_data"`
+}
+
+func resourceNsxtMPToPolicyPromotion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtMPToPolicyPromotionCreate,
+		Read:   resourceNsxtMPToPolicyPromotionRead,
+		Delete: resourceNsxtMPToPolicyPromotionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource": {
+				Type:        schema.TypeList,
+				Description: "MP objects to promote to Policy",
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "Type of the MP object",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(mpToPolicyPromotionTypeValues, false),
+						},
+						"manager_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the MP object",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"policy_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the policy object to create. If not set, NSX generates the ID",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"policy_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the promoted object",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getMPToPolicyPromotionRequestFromSchema(d *schema.ResourceData) mpToPolicyPromotionRequest {
+	request := mpToPolicyPromotionRequest{}
+	typeIndex := make(map[string]int)
+	for _, item := range d.Get("resource").([]interface{}) {
+		data := item.(map[string]interface{})
+		objType := data["type"].(string)
+		resourceID := mpToPolicyResourceID{
+			ManagerID: data["manager_id"].(string),
+			PolicyID:  data["policy_id"].(string),
+		}
+		if i, ok := typeIndex[objType]; ok {
+			request.MigrationData[i].ResourceIDs = append(request.MigrationData[i].ResourceIDs, resourceID)
+			continue
+		}
+		typeIndex[objType] = len(request.MigrationData)
+		request.MigrationData = append(request.MigrationData, mpToPolicyMigrationData{
+			Type:        objType,
+			ResourceIDs: []mpToPolicyResourceID{resourceID},
+		})
+	}
+
+	return request
+}
+
+func waitForMPToPolicyPromotion(m interface{}, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mpToPolicyPromotionNotStarted, mpToPolicyPromotionInProgress},
+		Target:  []string{mpToPolicyPromotionSuccess},
+		Refresh: func() (interface{}, string, error) {
+			var state map[string]interface{}
+			_, err := managerAPIRequest(m, http.MethodGet, "/migration/mp-to-policy/state", nil, &state)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error while querying MP to Policy promotion state: %v", err)
+			}
+
+			status, _ := state["status"].(string)
+			log.Printf("[DEBUG] MP to Policy promotion status %s", status)
+			if status == mpToPolicyPromotionNotStarted || status == mpToPolicyPromotionInProgress || status == mpToPolicyPromotionSuccess {
+				return state, status, nil
+			}
+
+			// Error details are reported with the full state
+			return state, status, fmt.Errorf("MP to Policy promotion ended with status %s: %v", status, state)
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func setMPToPolicyPromotionPathsInSchema(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	var resources []map[string]interface{}
+	for _, item := range d.Get("resource").([]interface{}) {
+		data := item.(map[string]interface{})
+		path, err := getPolicyPathByMPID(connector, data["manager_id"].(string), mpToPolicyPromotionPathPatterns[data["type"].(string)])
+		if err != nil {
+			return fmt.Errorf("Failed to resolve policy path for MP object %s: %v", data["manager_id"].(string), err)
+		}
+		resources = append(resources, map[string]interface{}{
+			"type":        data["type"],
+			"manager_id":  data["manager_id"],
+			"policy_id":   data["policy_id"],
+			"policy_path": path,
+		})
+	}
+
+	return d.Set("resource", resources)
+}
+
+func resourceNsxtMPToPolicyPromotionCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil || isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	request := getMPToPolicyPromotionRequestFromSchema(d)
+	log.Printf("[INFO] Promoting MP objects to Policy: %v", request)
+	_, err := managerAPIRequest(m, http.MethodPost, "/migration/mp-to-policy", request, nil)
+	if err != nil {
+		return fmt.Errorf("Error during MP to Policy promotion: %v", err)
+	}
+
+	err = waitForMPToPolicyPromotion(m, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(newUUID())
+
+	return resourceNsxtMPToPolicyPromotionRead(d, m)
+}
+
+func resourceNsxtMPToPolicyPromotionRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil || isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	return setMPToPolicyPromotionPathsInSchema(d, m)
+}
+
+func resourceNsxtMPToPolicyPromotionDelete(d *schema.ResourceData, m interface{}) error {
+	// Promotion can not be reverted, hence only state is cleared
+	return nil
+}
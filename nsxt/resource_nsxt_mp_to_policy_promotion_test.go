@@ -0,0 +1,50 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Promoted MP objects can not be deleted via MP API, hence the test
+// promotes a pre-existing NS group rather than creating one
+func TestAccResourceNsxtMPToPolicyPromotion_basic(t *testing.T) {
+	testResourceName := "nsxt_mp_to_policy_promotion.test"
+	mpID := os.Getenv("NSXT_TEST_PROMOTION_NS_GROUP_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccTestMP(t)
+			testAccPreCheck(t)
+			testAccNSXVersion(t, "3.1.0")
+			testAccEnvDefined(t, "NSXT_TEST_PROMOTION_NS_GROUP_ID")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtMPToPolicyPromotionTemplate(mpID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "resource.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "resource.0.manager_id", mpID),
+					resource.TestMatchResourceAttr(testResourceName, "resource.0.policy_path", policyGroupPathPattern),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtMPToPolicyPromotionTemplate(mpID string) string {
+	return fmt.Sprintf(`
+resource "nsxt_mp_to_policy_promotion" "test" {
+  resource {
+    type       = "NS_GROUP"
+    manager_id = "%s"
+  }
+}`, mpID)
+}
@@ -0,0 +1,474 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tags"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tags/tag_operations"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Tag bulk operations API only supports virtual machines at this point
+const policyBulkTagResourceTypeVM = "VirtualMachine"
+
+var policyBulkTagTargetKeys = []string{"virtual_machine_ids", "display_name_prefix"}
+
+func resourceNsxtPolicyBulkTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyBulkTagCreate,
+		Read:   resourceNsxtPolicyBulkTagRead,
+		Update: resourceNsxtPolicyBulkTagUpdate,
+		Delete: resourceNsxtPolicyBulkTagDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id": getNsxIDSchema(),
+			"path":   getPathSchema(),
+			"scope": {
+				Type:        schema.TypeString,
+				Description: "Scope of the tag to apply",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"tag": {
+				Type:        schema.TypeString,
+				Description: "Value of the tag to apply",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"virtual_machine_ids": {
+				Type:         schema.TypeSet,
+				Description:  "External IDs of virtual machines to apply the tag to",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: policyBulkTagTargetKeys,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"pretagged_virtual_machine_ids": {
+				Type:        schema.TypeSet,
+				Description: "External IDs of virtual machines that already had the tag when it was applied, and that are left intact on removal",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"display_name_prefix": {
+				Type:         schema.TypeString,
+				Description:  "Apply the tag to virtual machines with display name starting with this prefix at creation time",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: policyBulkTagTargetKeys,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the last tag operation",
+				Computed:    true,
+			},
+			"failed_resource": {
+				Type:        schema.TypeList,
+				Description: "Resources the last tag operation failed for",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the resource",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the resource",
+							Computed:    true,
+						},
+						"details": {
+							Type:        schema.TypeString,
+							Description: "Error details",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyBulkTagFailedResources(status model.TagBulkOperationStatus) []model.ResourceTagStatus {
+	var failed []model.ResourceTagStatus
+	for _, typeStatus := range append(status.ApplyTo, status.RemoveFrom...) {
+		for _, resourceStatus := range typeStatus.ResourceTagStatus {
+			if resourceStatus.TagStatus != nil && *resourceStatus.TagStatus == model.ResourceTagStatus_TAG_STATUS_ERROR {
+				failed = append(failed, resourceStatus)
+			}
+		}
+	}
+
+	return failed
+}
+
+func waitForPolicyBulkTagOperation(connector *client.RestConnector, id string, timeout time.Duration) (model.TagBulkOperationStatus, error) {
+	client := tag_operations.NewStatusClient(connector)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{model.TagBulkOperationStatus_STATUS_PENDING, model.TagBulkOperationStatus_STATUS_RUNNING},
+		Target:  []string{model.TagBulkOperationStatus_STATUS_SUCCESS, model.TagBulkOperationStatus_STATUS_ERROR},
+		Refresh: func() (interface{}, string, error) {
+			status, err := client.Get(id)
+			if err != nil {
+				return nil, "", logAPIError("Error while querying tag operation status", err)
+			}
+
+			if status.Status == nil {
+				return status, model.TagBulkOperationStatus_STATUS_PENDING, nil
+			}
+
+			log.Printf("[DEBUG] Tag operation %s status: %s", id, *status.Status)
+			return status, *status.Status, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+		Delay:      1 * time.Second,
+	}
+	result, err := stateConf.WaitForState()
+	if err != nil {
+		return model.TagBulkOperationStatus{}, err
+	}
+
+	return result.(model.TagBulkOperationStatus), nil
+}
+
+// Returns external IDs of virtual machines that currently have the tag
+func getPolicyBulkTagTaggedVMs(d *schema.ResourceData, m interface{}) (map[string]bool, error) {
+	connector := getPolicyConnector(m)
+	var scope *string
+	if value := d.Get("scope").(string); value != "" {
+		scope = &value
+	}
+	tag := d.Get("tag").(string)
+
+	client := tags.NewEffectiveResourcesClient(connector)
+	tagged := make(map[string]bool)
+	lister := func(info *paginationInfo) error {
+		result, err := client.List(getPaginationCursor(info), nil, nil, nil, nil, scope, nil, nil, &tag)
+		if err != nil {
+			return err
+		}
+		setPaginationInfo(info, len(result.Results), result.ResultCount, result.Cursor)
+		for _, ref := range result.Results {
+			if ref.TargetType != nil && *ref.TargetType != policyBulkTagResourceTypeVM {
+				continue
+			}
+			// Virtual machine ID is its external ID, which also ends its path
+			if ref.TargetId != nil {
+				tagged[*ref.TargetId] = true
+			} else if ref.Path != nil {
+				tagged[path.Base(*ref.Path)] = true
+			}
+		}
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	if err != nil || scope != nil || len(tagged) == 0 {
+		return tagged, err
+	}
+
+	// Without scope filter, effective resources match tag value under any
+	// scope, and do not report the scope. Tags of the virtual machines are
+	// checked for the tag with empty scope instead.
+	vms, err := listAllPolicyVirtualMachines(connector, m)
+	if err != nil {
+		return nil, err
+	}
+	exactTagged := make(map[string]bool)
+	for _, vm := range vms {
+		if vm.ExternalId == nil || !tagged[*vm.ExternalId] {
+			continue
+		}
+		if hasPolicyBulkTag(vm.Tags, "", tag) {
+			exactTagged[*vm.ExternalId] = true
+		}
+	}
+
+	return exactTagged, nil
+}
+
+func hasPolicyBulkTag(vmTags []model.Tag, scope string, tag string) bool {
+	for _, vmTag := range vmTags {
+		vmScope := ""
+		if vmTag.Scope != nil {
+			vmScope = *vmTag.Scope
+		}
+		if vmTag.Tag != nil && *vmTag.Tag == tag && vmScope == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Splits virtual machines into ones that already have the tag and ones that need it
+func splitPolicyBulkTagVMs(vmIDs []string, tagged map[string]bool) ([]string, []string) {
+	var pretagged []string
+	var untagged []string
+	for _, id := range vmIDs {
+		if tagged[id] {
+			pretagged = append(pretagged, id)
+		} else {
+			untagged = append(untagged, id)
+		}
+	}
+	return pretagged, untagged
+}
+
+func runPolicyBulkTagOperation(d *schema.ResourceData, connector *client.RestConnector, id string, applyTo []string, removeFrom []string, timeout time.Duration) error {
+	if len(applyTo) == 0 && len(removeFrom) == 0 {
+		return nil
+	}
+
+	scope := d.Get("scope").(string)
+	tag := d.Get("tag").(string)
+	resourceType := policyBulkTagResourceTypeVM
+	obj := model.TagBulkOperation{
+		Tag: &model.Tag{
+			Scope: &scope,
+			Tag:   &tag,
+		},
+	}
+	if len(applyTo) > 0 {
+		obj.ApplyTo = []model.ResourceInfo{{ResourceType: &resourceType, ResourceIds: applyTo}}
+	}
+	if len(removeFrom) > 0 {
+		obj.RemoveFrom = []model.ResourceInfo{{ResourceType: &resourceType, ResourceIds: removeFrom}}
+	}
+
+	// Operation intent is kept by NSX, and is overwritten with each run
+	client := tags.NewTagOperationsClient(connector)
+	existing, err := client.Get(id)
+	if err == nil {
+		obj.Revision = existing.Revision
+	} else if !isNotFoundError(err) {
+		return err
+	}
+
+	log.Printf("[INFO] Running tag operation %s: applying to %d and removing from %d virtual machines", id, len(applyTo), len(removeFrom))
+	_, err = client.Update(id, obj)
+	if err != nil {
+		return err
+	}
+
+	status, err := waitForPolicyBulkTagOperation(connector, id, timeout)
+	if err != nil {
+		return err
+	}
+
+	if status.Status != nil && *status.Status == model.TagBulkOperationStatus_STATUS_ERROR {
+		var failedIDs []string
+		for _, failed := range getPolicyBulkTagFailedResources(status) {
+			if failed.ResourceId != nil {
+				failedIDs = append(failedIDs, *failed.ResourceId)
+			}
+		}
+		return fmt.Errorf("Tag operation %s failed for resources: %s", id, strings.Join(failedIDs, ", "))
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyBulkTagExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := tags.NewTagOperationsClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicyBulkTagCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyBulkTagExists)
+	if err != nil {
+		return err
+	}
+
+	vmIDs := getStringListFromSchemaSet(d, "virtual_machine_ids")
+	if prefix := d.Get("display_name_prefix").(string); prefix != "" {
+		perfectMatch, prefixMatch, err := findNsxtPolicyVMByNamePrefix(connector, prefix, m)
+		if err != nil {
+			return err
+		}
+		for _, vm := range append(perfectMatch, prefixMatch...) {
+			if vm.ExternalId != nil {
+				vmIDs = append(vmIDs, *vm.ExternalId)
+			}
+		}
+		if len(vmIDs) == 0 {
+			return fmt.Errorf("No virtual machines found with display name prefix %s", prefix)
+		}
+	}
+
+	// Virtual machines that already have the tag are recorded, so that
+	// the tag is not removed from them on destroy
+	tagged, err := getPolicyBulkTagTaggedVMs(d, m)
+	if err != nil {
+		return handleCreateError("Bulk Tag", id, err)
+	}
+	pretagged, applyTo := splitPolicyBulkTagVMs(vmIDs, tagged)
+
+	// ID is set before running the operation, so that partially applied
+	// tags are cleaned up when failed resource is destroyed
+	d.SetId(id)
+	d.Set("nsx_id", id)
+	d.Set("virtual_machine_ids", vmIDs)
+	d.Set("pretagged_virtual_machine_ids", pretagged)
+
+	err = runPolicyBulkTagOperation(d, connector, id, applyTo, nil, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return handleCreateError("Bulk Tag", id, err)
+	}
+
+	return resourceNsxtPolicyBulkTagRead(d, m)
+}
+
+func resourceNsxtPolicyBulkTagRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Bulk Tag ID")
+	}
+
+	obj, err := tags.NewTagOperationsClient(connector).Get(id)
+	if err != nil {
+		return handleReadError(d, "Bulk Tag", id, err)
+	}
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+
+	// Virtual machines the tag was removed from outside of Terraform are
+	// dropped from state, so that the tag is applied again
+	tagged, err := getPolicyBulkTagTaggedVMs(d, m)
+	if err != nil {
+		return handleReadError(d, "Bulk Tag Virtual Machines", id, err)
+	}
+	var vmIDs []string
+	for _, vmID := range getStringListFromSchemaSet(d, "virtual_machine_ids") {
+		if tagged[vmID] {
+			vmIDs = append(vmIDs, vmID)
+		} else {
+			log.Printf("[INFO] Tag operation %s: tag is missing on virtual machine %s", id, vmID)
+		}
+	}
+	d.Set("virtual_machine_ids", vmIDs)
+
+	status, err := tag_operations.NewStatusClient(connector).Get(id)
+	if err != nil {
+		return handleReadError(d, "Bulk Tag Status", id, err)
+	}
+	d.Set("status", status.Status)
+
+	var failedList []map[string]interface{}
+	for _, failed := range getPolicyBulkTagFailedResources(status) {
+		elem := make(map[string]interface{})
+		elem["resource_id"] = failed.ResourceId
+		elem["display_name"] = failed.ResourceDisplayName
+		elem["details"] = failed.Details
+		failedList = append(failedList, elem)
+	}
+
+	return d.Set("failed_resource", failedList)
+}
+
+func resourceNsxtPolicyBulkTagUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Bulk Tag ID")
+	}
+
+	if d.HasChange("virtual_machine_ids") {
+		connector := getPolicyConnector(m)
+		oldValue, newValue := d.GetChange("virtual_machine_ids")
+		oldSet := oldValue.(*schema.Set)
+		newSet := newValue.(*schema.Set)
+		added := interfaceListToStringList(newSet.Difference(oldSet).List())
+		removed := interfaceListToStringList(oldSet.Difference(newSet).List())
+
+		tagged, err := getPolicyBulkTagTaggedVMs(d, m)
+		if err != nil {
+			return handleUpdateError("Bulk Tag", id, err)
+		}
+
+		// Added virtual machines that already have the tag are recorded as
+		// pre-tagged, and pre-tagged virtual machines keep the tag on removal
+		pretaggedSet := d.Get("pretagged_virtual_machine_ids").(*schema.Set)
+		addedPretagged, applyTo := splitPolicyBulkTagVMs(added, tagged)
+		var removeFrom []string
+		for _, vmID := range removed {
+			if !pretaggedSet.Contains(vmID) {
+				removeFrom = append(removeFrom, vmID)
+			}
+			pretaggedSet.Remove(vmID)
+		}
+		for _, vmID := range applyTo {
+			pretaggedSet.Remove(vmID)
+		}
+		for _, vmID := range addedPretagged {
+			pretaggedSet.Add(vmID)
+		}
+		d.Set("pretagged_virtual_machine_ids", pretaggedSet)
+
+		err = runPolicyBulkTagOperation(d, connector, id, applyTo, removeFrom, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return handleUpdateError("Bulk Tag", id, err)
+		}
+	}
+
+	return resourceNsxtPolicyBulkTagRead(d, m)
+}
+
+func resourceNsxtPolicyBulkTagDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Bulk Tag ID")
+	}
+
+	// NSX does not allow deleting tag operations, hence deletion removes
+	// the tag from all virtual machines it was applied to, except for those
+	// that had the tag before
+	pretaggedSet := d.Get("pretagged_virtual_machine_ids").(*schema.Set)
+	var vmIDs []string
+	for _, vmID := range getStringListFromSchemaSet(d, "virtual_machine_ids") {
+		if !pretaggedSet.Contains(vmID) {
+			vmIDs = append(vmIDs, vmID)
+		}
+	}
+	err := runPolicyBulkTagOperation(d, getPolicyConnector(m), id, nil, vmIDs, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleDeleteError("Bulk Tag", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestHasPolicyBulkTag(t *testing.T) {
+	scope := "tier"
+	tag := "web"
+	otherTag := "db"
+	vmTags := []model.Tag{{Scope: &scope, Tag: &tag}, {Tag: &otherTag}}
+
+	cases := []struct {
+		scope    string
+		tag      string
+		expected bool
+	}{
+		{"tier", "web", true},
+		{"", "web", false},
+		{"app", "web", false},
+		{"", "db", true},
+		{"tier", "db", false},
+	}
+	for _, c := range cases {
+		if result := hasPolicyBulkTag(vmTags, c.scope, c.tag); result != c.expected {
+			t.Errorf("hasPolicyBulkTag(%q, %q) = %v, expected %v", c.scope, c.tag, result, c.expected)
+		}
+	}
+}
+
+func TestAccResourceNsxtPolicyBulkTag_basic(t *testing.T) {
+	vmID := getTestVMID()
+	testResourceName := "nsxt_policy_bulk_tag.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_VM_ID")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyBulkTagTemplate(vmID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyBulkTagExists),
+					resource.TestCheckResourceAttr(testResourceName, "scope", "terraform-bulk"),
+					resource.TestCheckResourceAttr(testResourceName, "tag", "app"),
+					resource.TestCheckResourceAttr(testResourceName, "virtual_machine_ids.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "status", "Success"),
+					resource.TestCheckResourceAttr(testResourceName, "failed_resource.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyBulkTagTemplate(vmID string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_bulk_tag" "test" {
+  scope               = "terraform-bulk"
+  tag                 = "app"
+  virtual_machine_ids = ["%s"]
+}`, vmID)
+}
@@ -0,0 +1,312 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Certificates that expire within this period are reported with a warning
+const policyCertificateExpiryWarningPeriod = 30 * 24 * time.Hour
+
+func resourceNsxtPolicyCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create:      resourceNsxtPolicyCertificateCreate,
+		ReadContext: resourceNsxtPolicyCertificateRead,
+		Update:      resourceNsxtPolicyCertificateUpdate,
+		Delete:      resourceNsxtPolicyCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"pem_encoded": {
+				Type:        schema.TypeString,
+				Description: "PEM encoded certificate, or certificate chain starting with the leaf certificate",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Description: "PEM encoded private key of the leaf certificate",
+				Optional:    true,
+				Sensitive:   true,
+				ForceNew:    true,
+			},
+			"passphrase": {
+				Type:        schema.TypeString,
+				Description: "Passphrase of the private key",
+				Optional:    true,
+				Sensitive:   true,
+				ForceNew:    true,
+			},
+			"key_algo": {
+				Type:        schema.TypeString,
+				Description: "Key algorithm contained in this certificate",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Description: "Subject of the leaf certificate",
+				Computed:    true,
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Description: "Issuer of the leaf certificate",
+				Computed:    true,
+			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Description: "Start of validity period of the leaf certificate, in RFC3339 format",
+				Computed:    true,
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Description: "Expiration time of the leaf certificate, in RFC3339 format",
+				Computed:    true,
+			},
+			"is_ca": {
+				Type:        schema.TypeBool,
+				Description: "Whether the leaf certificate is a CA certificate",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyCertificateExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	_, err := getPolicyCertificateByID(id, connector, isGlobalManager)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyCertificateByID(id string, connector *client.RestConnector, isGlobalManager bool) (model.TlsCertificate, error) {
+	details := true
+	if isGlobalManager {
+		client := gm_infra.NewCertificatesClient(connector)
+		gmObj, err := client.Get(id, &details)
+		if err != nil {
+			return model.TlsCertificate{}, err
+		}
+
+		lmObj, err := convertModelBindingType(gmObj, gm_model.TlsCertificateBindingType(), model.TlsCertificateBindingType())
+		if err != nil {
+			return model.TlsCertificate{}, err
+		}
+		return lmObj.(model.TlsCertificate), nil
+	}
+
+	client := infra.NewCertificatesClient(connector)
+	return client.Get(id, &details)
+}
+
+// Returns warnings for certificates in the chain that expire soon or already expired
+func getPolicyCertificateExpiryWarnings(id string, certificate model.TlsCertificate) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, details := range certificate.Details {
+		if details.NotAfter == nil {
+			continue
+		}
+
+		expiry := time.Unix(0, *details.NotAfter*int64(time.Millisecond))
+		remaining := time.Until(expiry)
+		if remaining > policyCertificateExpiryWarningPeriod {
+			continue
+		}
+
+		subject := ""
+		if details.Subject != nil {
+			subject = *details.Subject
+		}
+		summary := fmt.Sprintf("Certificate %s is about to expire", id)
+		if remaining <= 0 {
+			summary = fmt.Sprintf("Certificate %s has expired", id)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  summary,
+			Detail:   fmt.Sprintf("Certificate with subject %s expires at %s", subject, expiry.UTC().Format(time.RFC3339)),
+		})
+	}
+
+	return diags
+}
+
+func policyCertificatePatch(id string, d *schema.ResourceData, connector *client.RestConnector, isGlobalManager bool) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	pemEncoded := d.Get("pem_encoded").(string)
+	privateKey := d.Get("private_key").(string)
+	passphrase := d.Get("passphrase").(string)
+	keyAlgo := d.Get("key_algo").(string)
+
+	obj := model.TlsTrustData{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		PemEncoded:  &pemEncoded,
+	}
+
+	if len(privateKey) > 0 {
+		obj.PrivateKey = &privateKey
+	}
+	if len(passphrase) > 0 {
+		obj.Passphrase = &passphrase
+	}
+	if len(keyAlgo) > 0 {
+		obj.KeyAlgo = &keyAlgo
+	}
+
+	if isGlobalManager {
+		gmObj, convErr := convertModelBindingType(obj, model.TlsTrustDataBindingType(), gm_model.TlsTrustDataBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		client := gm_infra.NewCertificatesClient(connector)
+		return client.Patch(id, gmObj.(gm_model.TlsTrustData))
+	}
+
+	client := infra.NewCertificatesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyCertificateCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyCertificateExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Certificate with ID %s", id)
+	err = policyCertificatePatch(id, d, connector, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleCreateError("Certificate", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	_, err = policyCertificateRead(d, m)
+	return err
+}
+
+func resourceNsxtPolicyCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	obj, err := policyCertificateRead(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if obj == nil {
+		return nil
+	}
+
+	return getPolicyCertificateExpiryWarnings(d.Id(), *obj)
+}
+
+func policyCertificateRead(d *schema.ResourceData, m interface{}) (*model.TlsCertificate, error) {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return nil, fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	obj, err := getPolicyCertificateByID(id, connector, isPolicyGlobalManager(m))
+	if err != nil {
+		return nil, handleReadError(d, "Certificate", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	// PEM is only set when missing from state (on import), to avoid
+	// spurious diffs due to PEM formatting
+	if d.Get("pem_encoded").(string) == "" {
+		d.Set("pem_encoded", obj.PemEncoded)
+	}
+
+	if len(obj.Details) > 0 {
+		leaf := obj.Details[0]
+		d.Set("subject", leaf.Subject)
+		d.Set("issuer", leaf.Issuer)
+		d.Set("not_before", formatEpochMillisTime(leaf.NotBefore))
+		d.Set("not_after", formatEpochMillisTime(leaf.NotAfter))
+		d.Set("is_ca", leaf.IsCa)
+	}
+
+	return &obj, nil
+}
+
+func resourceNsxtPolicyCertificateUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	log.Printf("[INFO] Updating Certificate with ID %s", id)
+	err := policyCertificatePatch(id, d, connector, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleUpdateError("Certificate", id, err)
+	}
+
+	_, err = policyCertificateRead(d, m)
+	return err
+}
+
+func resourceNsxtPolicyCertificateDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	connector := getPolicyConnector(m)
+	var err error
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewCertificatesClient(connector)
+		err = client.Delete(id)
+	} else {
+		client := infra.NewCertificatesClient(connector)
+		err = client.Delete(id)
+	}
+
+	if err != nil {
+		return handleDeleteError("Certificate", id, err)
+	}
+
+	return nil
+}
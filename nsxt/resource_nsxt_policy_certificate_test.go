@@ -0,0 +1,122 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyCertificateCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+}
+
+var accTestPolicyCertificateUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+}
+
+func TestAccResourceNsxtPolicyCertificate_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_certificate.test"
+	certPem, keyPem, err := generatePrincipalIdentityCertificate("terraform-acc-test.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, accTestPolicyCertificateUpdateAttributes["display_name"], "nsxt_policy_certificate", resourceNsxtPolicyCertificateExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCertificateTemplate(true, certPem, keyPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyCertificateExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyCertificateCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyCertificateCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "subject", "CN=terraform-acc-test.example.com"),
+					resource.TestCheckResourceAttr(testResourceName, "is_ca", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "issuer"),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_before"),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_after"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyCertificateTemplate(false, certPem, keyPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyCertificateExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyCertificateUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyCertificateUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "subject", "CN=terraform-acc-test.example.com"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyCertificate_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_certificate.test"
+	certPem, keyPem, err := generatePrincipalIdentityCertificate("terraform-acc-test.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, accTestPolicyCertificateCreateAttributes["display_name"], "nsxt_policy_certificate", resourceNsxtPolicyCertificateExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCertificateTemplate(true, certPem, keyPem),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pem_encoded", "private_key"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyCertificateTemplate(createFlow bool, certPem string, keyPem string) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyCertificateCreateAttributes
+	} else {
+		attrMap = accTestPolicyCertificateUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_certificate" "test" {
+  display_name = "%s"
+  description  = "%s"
+  pem_encoded  = <<EOT
+%sEOT
+  private_key  = <<EOT
+%sEOT
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], certPem, keyPem)
+}
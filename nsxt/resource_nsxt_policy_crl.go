@@ -0,0 +1,206 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyCrl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyCrlCreate,
+		Read:   resourceNsxtPolicyCrlRead,
+		Update: resourceNsxtPolicyCrlUpdate,
+		Delete: resourceNsxtPolicyCrlDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"pem_encoded": {
+				Type:        schema.TypeString,
+				Description: "PEM encoded Certificate Revocation List",
+				Required:    true,
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Description: "Issuer of the CRL",
+				Computed:    true,
+			},
+			"next_update": {
+				Type:        schema.TypeString,
+				Description: "Next update time of the CRL",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyCrlExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	_, err := getPolicyCrlByID(id, connector, isGlobalManager)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyCrlByID(id string, connector *client.RestConnector, isGlobalManager bool) (model.TlsCrl, error) {
+	details := true
+	if isGlobalManager {
+		client := gm_infra.NewCrlsClient(connector)
+		gmObj, err := client.Get(id, &details)
+		if err != nil {
+			return model.TlsCrl{}, err
+		}
+
+		lmObj, err := convertModelBindingType(gmObj, gm_model.TlsCrlBindingType(), model.TlsCrlBindingType())
+		if err != nil {
+			return model.TlsCrl{}, err
+		}
+		return lmObj.(model.TlsCrl), nil
+	}
+
+	client := infra.NewCrlsClient(connector)
+	return client.Get(id, &details)
+}
+
+func policyCrlPatch(id string, d *schema.ResourceData, connector *client.RestConnector, isGlobalManager bool) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	pemEncoded := d.Get("pem_encoded").(string)
+
+	obj := model.TlsCrl{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		PemEncoded:  &pemEncoded,
+	}
+
+	if isGlobalManager {
+		gmObj, convErr := convertModelBindingType(obj, model.TlsCrlBindingType(), gm_model.TlsCrlBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		client := gm_infra.NewCrlsClient(connector)
+		return client.Patch(id, gmObj.(gm_model.TlsCrl))
+	}
+
+	client := infra.NewCrlsClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyCrlCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyCrlExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating CRL with ID %s", id)
+	err = policyCrlPatch(id, d, connector, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleCreateError("CRL", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyCrlRead(d, m)
+}
+
+func resourceNsxtPolicyCrlRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CRL ID")
+	}
+
+	obj, err := getPolicyCrlByID(id, connector, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleReadError(d, "CRL", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	// PEM is only set when missing from state (on import), to avoid
+	// spurious diffs due to PEM formatting
+	if d.Get("pem_encoded").(string) == "" {
+		d.Set("pem_encoded", obj.PemEncoded)
+	}
+
+	if obj.Details != nil {
+		d.Set("issuer", obj.Details.Issuer)
+		d.Set("next_update", obj.Details.NextUpdate)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyCrlUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CRL ID")
+	}
+
+	log.Printf("[INFO] Updating CRL with ID %s", id)
+	err := policyCrlPatch(id, d, connector, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleUpdateError("CRL", id, err)
+	}
+
+	return resourceNsxtPolicyCrlRead(d, m)
+}
+
+func resourceNsxtPolicyCrlDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CRL ID")
+	}
+
+	connector := getPolicyConnector(m)
+	var err error
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewCrlsClient(connector)
+		err = client.Delete(id)
+	} else {
+		client := infra.NewCrlsClient(connector)
+		err = client.Delete(id)
+	}
+
+	if err != nil {
+		return handleDeleteError("CRL", id, err)
+	}
+
+	return nil
+}
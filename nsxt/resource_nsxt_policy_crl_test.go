@@ -0,0 +1,170 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyCrlCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+}
+
+var accTestPolicyCrlUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+}
+
+func TestAccResourceNsxtPolicyCrl_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_crl.test"
+	crlPem, err := testAccNsxtPolicyGenerateCrl(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	updatedCrlPem, err := testAccNsxtPolicyGenerateCrl(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, accTestPolicyCrlUpdateAttributes["display_name"], "nsxt_policy_crl", resourceNsxtPolicyCrlExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCrlTemplate(true, crlPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyCrlExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyCrlCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyCrlCreateAttributes["description"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "issuer"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyCrlTemplate(false, updatedCrlPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyCrlExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyCrlUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyCrlUpdateAttributes["description"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "issuer"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyCrl_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_crl.test"
+	crlPem, err := testAccNsxtPolicyGenerateCrl(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, accTestPolicyCrlCreateAttributes["display_name"], "nsxt_policy_crl", resourceNsxtPolicyCrlExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCrlTemplate(true, crlPem),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pem_encoded"},
+			},
+		},
+	})
+}
+
+// Generates a CRL signed by a throwaway CA, revoking a single serial number
+func testAccNsxtPolicyGenerateCrl(crlNumber int64) (string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	caTemplate := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform-acc-test-ca"},
+		NotBefore:             now,
+		NotAfter:              now.AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, &caTemplate, &caTemplate, &key.PublicKey, key)
+	if err != nil {
+		return "", err
+	}
+	caCert, err := x509.ParseCertificate(caDer)
+	if err != nil {
+		return "", err
+	}
+
+	crlTemplate := x509.RevocationList{
+		Number:     big.NewInt(crlNumber),
+		ThisUpdate: now,
+		NextUpdate: now.AddDate(0, 1, 0),
+		RevokedCertificates: []pkix.RevokedCertificate{{
+			SerialNumber:   big.NewInt(crlNumber + 100),
+			RevocationTime: now,
+		}},
+	}
+	crlDer, err := x509.CreateRevocationList(rand.Reader, &crlTemplate, caCert, key)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crlDer})), nil
+}
+
+func testAccNsxtPolicyCrlTemplate(createFlow bool, crlPem string) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyCrlCreateAttributes
+	} else {
+		attrMap = accTestPolicyCrlUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_crl" "test" {
+  display_name = "%s"
+  description  = "%s"
+  pem_encoded  = <<EOT
+%sEOT
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], crlPem)
+}
@@ -0,0 +1,196 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyDNSSecurityProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyDNSSecurityProfileCreate,
+		Read:   resourceNsxtPolicyDNSSecurityProfileRead,
+		Update: resourceNsxtPolicyDNSSecurityProfileUpdate,
+		Delete: resourceNsxtPolicyDNSSecurityProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Time to live for DNS cache entry in seconds, 0 means TTL from DNS response is used",
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 864000),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyDNSSecurityProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDnsSecurityProfilesClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewDnsSecurityProfilesClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func policyDNSSecurityProfilePatch(id string, d *schema.ResourceData, connector client.Connector, isGlobalManager bool) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	ttl := int64(d.Get("ttl").(int))
+
+	obj := model.DnsSecurityProfile{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		Ttl:         &ttl,
+	}
+
+	// Create the resource using PATCH
+	if isGlobalManager {
+		gmObj, convErr := convertModelBindingType(obj, model.DnsSecurityProfileBindingType(), gm_model.DnsSecurityProfileBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		client := gm_infra.NewDnsSecurityProfilesClient(connector)
+		return client.Patch(id, gmObj.(gm_model.DnsSecurityProfile), nil)
+	}
+
+	client := infra.NewDnsSecurityProfilesClient(connector)
+	return client.Patch(id, obj, nil)
+}
+
+func resourceNsxtPolicyDNSSecurityProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDNSSecurityProfileExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Dns Security Profile with ID %s", id)
+	err = policyDNSSecurityProfilePatch(id, d, connector, isPolicyGlobalManager(m))
+
+	if err != nil {
+		return handleCreateError("Dns Security Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyDNSSecurityProfileRead(d, m)
+}
+
+func resourceNsxtPolicyDNSSecurityProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Dns Security Profile ID")
+	}
+
+	var obj model.DnsSecurityProfile
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDnsSecurityProfilesClient(connector)
+		gmObj, err := client.Get(id)
+		if err != nil {
+			return handleReadError(d, "Dns Security Profile", id, err)
+		}
+
+		lmObj, err := convertModelBindingType(gmObj, gm_model.DnsSecurityProfileBindingType(), model.DnsSecurityProfileBindingType())
+		if err != nil {
+			return err
+		}
+		obj = lmObj.(model.DnsSecurityProfile)
+	} else {
+		client := infra.NewDnsSecurityProfilesClient(connector)
+		var err error
+		obj, err = client.Get(id)
+		if err != nil {
+			return handleReadError(d, "Dns Security Profile", id, err)
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("ttl", obj.Ttl)
+
+	return nil
+}
+
+func resourceNsxtPolicyDNSSecurityProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Dns Security Profile ID")
+	}
+
+	log.Printf("[INFO] Updating Dns Security Profile with ID %s", id)
+	err := policyDNSSecurityProfilePatch(id, d, connector, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleUpdateError("Dns Security Profile", id, err)
+	}
+
+	return resourceNsxtPolicyDNSSecurityProfileRead(d, m)
+}
+
+func resourceNsxtPolicyDNSSecurityProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Dns Security Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	var err error
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDnsSecurityProfilesClient(connector)
+		err = client.Delete(id, nil)
+	} else {
+		client := infra.NewDnsSecurityProfilesClient(connector)
+		err = client.Delete(id, nil)
+	}
+
+	if err != nil {
+		return handleDeleteError("Dns Security Profile", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_groups "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains/groups"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/groups"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyDNSSecurityProfileBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyDNSSecurityProfileBindingCreate,
+		Read:   resourceNsxtPolicyDNSSecurityProfileBindingRead,
+		Update: resourceNsxtPolicyDNSSecurityProfileBindingUpdate,
+		Delete: resourceNsxtPolicyDNSSecurityProfileBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyDNSSecurityProfileBindingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"group_path":   getPolicyPathSchema(true, true, "Policy path of the Group to bind the profile to"),
+			"profile_path": getPolicyPathSchema(true, false, "Policy path of the Dns Security Profile"),
+			"sequence_number": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Sequence number used to resolve conflicts between different profiles applied to the same Group",
+				Default:     0,
+			},
+		},
+	}
+}
+
+func parseDNSSecurityProfileBindingGroupPath(groupPath string) (string, string, error) {
+	domainID := getDomainFromResourcePath(groupPath)
+	groupID := getResourceIDFromResourcePath(groupPath, "groups")
+	if domainID == "" || groupID == "" {
+		return "", "", fmt.Errorf("group_path %s is not a valid Group path", groupPath)
+	}
+
+	return domainID, groupID, nil
+}
+
+func getNsxtPolicyDNSSecurityProfileBindingByID(connector *client.RestConnector, domainID string, groupID string, id string, isGlobalManager bool) (model.DnsSecurityProfileBindingMap, error) {
+	if isGlobalManager {
+		client := gm_groups.NewDnsSecurityProfileBindingMapsClient(connector)
+		gmObj, err := client.Get(domainID, groupID, id)
+		if err != nil {
+			return model.DnsSecurityProfileBindingMap{}, err
+		}
+
+		lmObj, err := convertModelBindingType(gmObj, gm_model.DnsSecurityProfileBindingMapBindingType(), model.DnsSecurityProfileBindingMapBindingType())
+		if err != nil {
+			return model.DnsSecurityProfileBindingMap{}, err
+		}
+		return lmObj.(model.DnsSecurityProfileBindingMap), nil
+	}
+
+	client := groups.NewDnsSecurityProfileBindingMapsClient(connector)
+	return client.Get(domainID, groupID, id)
+}
+
+func policyDNSSecurityProfileBindingPatch(domainID string, groupID string, id string, d *schema.ResourceData, connector client.Connector, isGlobalManager bool) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	profilePath := d.Get("profile_path").(string)
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+
+	obj := model.DnsSecurityProfileBindingMap{
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		ProfilePath:    &profilePath,
+		SequenceNumber: &sequenceNumber,
+	}
+
+	if isGlobalManager {
+		gmObj, convErr := convertModelBindingType(obj, model.DnsSecurityProfileBindingMapBindingType(), gm_model.DnsSecurityProfileBindingMapBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		client := gm_groups.NewDnsSecurityProfileBindingMapsClient(connector)
+		return client.Patch(domainID, groupID, id, gmObj.(gm_model.DnsSecurityProfileBindingMap))
+	}
+
+	client := groups.NewDnsSecurityProfileBindingMapsClient(connector)
+	return client.Patch(domainID, groupID, id, obj)
+}
+
+func resourceNsxtPolicyDNSSecurityProfileBindingCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	isGlobalManager := isPolicyGlobalManager(m)
+
+	domainID, groupID, err := parseDNSSecurityProfileBindingGroupPath(d.Get("group_path").(string))
+	if err != nil {
+		return err
+	}
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		_, err := getNsxtPolicyDNSSecurityProfileBindingByID(connector, domainID, groupID, id, isGlobalManager)
+		if err == nil {
+			return fmt.Errorf("Dns Security Profile Binding with nsx_id '%s' already exists", id)
+		} else if !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Creating Dns Security Profile Binding with ID %s", id)
+	err = policyDNSSecurityProfileBindingPatch(domainID, groupID, id, d, connector, isGlobalManager)
+	if err != nil {
+		return handleCreateError("Dns Security Profile Binding", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyDNSSecurityProfileBindingRead(d, m)
+}
+
+func resourceNsxtPolicyDNSSecurityProfileBindingRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Dns Security Profile Binding ID")
+	}
+
+	domainID, groupID, err := parseDNSSecurityProfileBindingGroupPath(d.Get("group_path").(string))
+	if err != nil {
+		return err
+	}
+
+	obj, err := getNsxtPolicyDNSSecurityProfileBindingByID(connector, domainID, groupID, id, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleReadError(d, "Dns Security Profile Binding", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("profile_path", obj.ProfilePath)
+	d.Set("sequence_number", obj.SequenceNumber)
+
+	return nil
+}
+
+func resourceNsxtPolicyDNSSecurityProfileBindingUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Dns Security Profile Binding ID")
+	}
+
+	domainID, groupID, err := parseDNSSecurityProfileBindingGroupPath(d.Get("group_path").(string))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating Dns Security Profile Binding with ID %s", id)
+	err = policyDNSSecurityProfileBindingPatch(domainID, groupID, id, d, connector, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleUpdateError("Dns Security Profile Binding", id, err)
+	}
+
+	return resourceNsxtPolicyDNSSecurityProfileBindingRead(d, m)
+}
+
+func resourceNsxtPolicyDNSSecurityProfileBindingDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Dns Security Profile Binding ID")
+	}
+
+	domainID, groupID, err := parseDNSSecurityProfileBindingGroupPath(d.Get("group_path").(string))
+	if err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	if isPolicyGlobalManager(m) {
+		client := gm_groups.NewDnsSecurityProfileBindingMapsClient(connector)
+		err = client.Delete(domainID, groupID, id)
+	} else {
+		client := groups.NewDnsSecurityProfileBindingMapsClient(connector)
+		err = client.Delete(domainID, groupID, id)
+	}
+
+	if err != nil {
+		return handleDeleteError("Dns Security Profile Binding", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyDNSSecurityProfileBindingImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importPath := d.Id()
+	s := strings.Split(importPath, "/dns-security-profile-binding-maps/")
+	if len(s) != 2 || !isPolicyPath(importPath) {
+		return nil, fmt.Errorf("Please provide <group-path>/dns-security-profile-binding-maps/<binding-id> as an input")
+	}
+
+	d.Set("group_path", s[0])
+	d.SetId(s[1])
+
+	return []*schema.ResourceData{d}, nil
+}
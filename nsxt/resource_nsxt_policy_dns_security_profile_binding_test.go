@@ -0,0 +1,188 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyDNSSecurityProfileBindingCreateAttributes = map[string]string{
+	"display_name":    getAccTestResourceName(),
+	"description":     "terraform created",
+	"sequence_number": "2",
+}
+
+var accTestPolicyDNSSecurityProfileBindingUpdateAttributes = map[string]string{
+	"display_name":    getAccTestResourceName(),
+	"description":     "terraform updated",
+	"sequence_number": "5",
+}
+
+func TestAccResourceNsxtPolicyDNSSecurityProfileBinding_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_dns_security_profile_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccNSXVersion(t, "3.1.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyDNSSecurityProfileBindingCheckDestroy(state, accTestPolicyDNSSecurityProfileBindingUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDNSSecurityProfileBindingTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyDNSSecurityProfileBindingExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyDNSSecurityProfileBindingCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyDNSSecurityProfileBindingCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", accTestPolicyDNSSecurityProfileBindingCreateAttributes["sequence_number"]),
+					resource.TestCheckResourceAttrPair(testResourceName, "group_path", "nsxt_policy_group.test", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "profile_path", "nsxt_policy_dns_security_profile.test", "path"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyDNSSecurityProfileBindingTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyDNSSecurityProfileBindingExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyDNSSecurityProfileBindingUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyDNSSecurityProfileBindingUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", accTestPolicyDNSSecurityProfileBindingUpdateAttributes["sequence_number"]),
+					resource.TestCheckResourceAttrPair(testResourceName, "group_path", "nsxt_policy_group.test", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "profile_path", "nsxt_policy_dns_security_profile.test", "path"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyDNSSecurityProfileBinding_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_dns_security_profile_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccNSXVersion(t, "3.1.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyDNSSecurityProfileBindingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDNSSecurityProfileBindingTemplate(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNsxtPolicyDNSSecurityProfileBindingImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyDNSSecurityProfileBindingImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_dns_security_profile_binding.test"]
+	if !ok {
+		return "", fmt.Errorf("Policy Dns Security Profile Binding resource not found in resources")
+	}
+	path := rs.Primary.Attributes["path"]
+	if path == "" {
+		return "", fmt.Errorf("Policy Dns Security Profile Binding path not set in resources")
+	}
+	return path, nil
+}
+
+func testAccNsxtPolicyDNSSecurityProfileBindingExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Dns Security Profile Binding resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Dns Security Profile Binding resource ID not set in resources")
+		}
+
+		domainID, groupID, err := parseDNSSecurityProfileBindingGroupPath(rs.Primary.Attributes["group_path"])
+		if err != nil {
+			return err
+		}
+
+		_, err = getNsxtPolicyDNSSecurityProfileBindingByID(connector, domainID, groupID, resourceID, testAccIsGlobalManager())
+		if err != nil {
+			return fmt.Errorf("Error while retrieving policy Dns Security Profile Binding ID %s. Error: %v", resourceID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyDNSSecurityProfileBindingCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_dns_security_profile_binding" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		domainID, groupID, err := parseDNSSecurityProfileBindingGroupPath(rs.Primary.Attributes["group_path"])
+		if err != nil {
+			return err
+		}
+
+		_, err = getNsxtPolicyDNSSecurityProfileBindingByID(connector, domainID, groupID, resourceID, testAccIsGlobalManager())
+		if err == nil {
+			return fmt.Errorf("Policy Dns Security Profile Binding %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyDNSSecurityProfileBindingTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyDNSSecurityProfileBindingCreateAttributes
+	} else {
+		attrMap = accTestPolicyDNSSecurityProfileBindingUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "terraform-dns-security-group"
+}
+
+resource "nsxt_policy_dns_security_profile" "test" {
+  display_name = "terraform-dns-security-profile"
+  ttl          = 300
+}
+
+resource "nsxt_policy_dns_security_profile_binding" "test" {
+  display_name    = "%s"
+  description     = "%s"
+  group_path      = nsxt_policy_group.test.path
+  profile_path    = nsxt_policy_dns_security_profile.test.path
+  sequence_number = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["sequence_number"])
+}
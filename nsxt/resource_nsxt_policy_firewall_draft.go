@@ -0,0 +1,231 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyFirewallDraft() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallDraftCreate,
+		Read:   resourceNsxtPolicyFirewallDraftRead,
+		Update: resourceNsxtPolicyFirewallDraftUpdate,
+		Delete: resourceNsxtPolicyFirewallDraftDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":         getNsxIDSchema(),
+			"path":           getPathSchema(),
+			"display_name":   getDisplayNameSchema(),
+			"description":    getDescriptionSchema(),
+			"revision":       getRevisionSchema(),
+			"tag":            getTagsSchema(),
+			"ref_draft_path": getPolicyPathSchema(false, true, "Policy path of the draft this draft is created against"),
+			"security_policy_paths": {
+				Type:        schema.TypeList,
+				Description: "Policy paths of Security Policies to capture in this draft",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        getElemPolicyPathSchema(),
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Description: "Flag to lock the draft, so that no other user is able to modify or publish it",
+				Optional:    true,
+				Default:     false,
+			},
+			"lock_comments": {
+				Type:        schema.TypeString,
+				Description: "Comments for draft lock or unlock",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyFirewallDraftExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewDraftsClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyFirewallDraftUserArea(d *schema.ResourceData, connector *client.RestConnector) (*model.Infra, error) {
+	policyPaths := getStringListFromSchemaList(d, "security_policy_paths")
+	if len(policyPaths) == 0 {
+		return nil, nil
+	}
+
+	var infraChildren []*data.StructValue
+	for _, policyPath := range policyPaths {
+		domain := getDomainFromResourcePath(policyPath)
+		policyID := getPolicyIDFromPath(policyPath)
+		if domain == "" {
+			return nil, fmt.Errorf("Failed to extract domain from Security Policy path %s", policyPath)
+		}
+
+		policy, err := getSecurityPolicyInDomain(policyID, domain, connector, false)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to retrieve Security Policy %s: %v", policyPath, err)
+		}
+
+		childDomain, err := createChildDomainWithSecurityPolicy(domain, policyID, policy)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to create H-API for Security Policy %s: %v", policyPath, err)
+		}
+		infraChildren = append(infraChildren, childDomain)
+	}
+
+	infraType := "Infra"
+	return &model.Infra{
+		Children:     infraChildren,
+		ResourceType: &infraType,
+	}, nil
+}
+
+func policyFirewallDraftPatch(id string, d *schema.ResourceData, connector *client.RestConnector) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	refDraftPath := d.Get("ref_draft_path").(string)
+	locked := d.Get("locked").(bool)
+	lockComments := d.Get("lock_comments").(string)
+
+	obj := model.PolicyDraft{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		Locked:      &locked,
+	}
+
+	// Security policies are only captured on create, since the draft is
+	// re-created when the list changes
+	if d.IsNewResource() {
+		userArea, err := getPolicyFirewallDraftUserArea(d, connector)
+		if err != nil {
+			return err
+		}
+		obj.UserArea = userArea
+	}
+
+	if len(refDraftPath) > 0 {
+		obj.RefDraftPath = &refDraftPath
+	}
+
+	if len(lockComments) > 0 {
+		obj.LockComments = &lockComments
+	}
+
+	client := infra.NewDraftsClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyFirewallDraftCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyFirewallDraftExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Firewall Draft with ID %s", id)
+	err = policyFirewallDraftPatch(id, d, connector)
+	if err != nil {
+		return handleCreateError("Firewall Draft", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyFirewallDraftRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallDraftRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Draft ID")
+	}
+
+	client := infra.NewDraftsClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Firewall Draft", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	// NOTE: security_policy_paths are captured into user area of the draft
+	// and can not be reliably derived back from it, hence are not imported
+	d.Set("ref_draft_path", obj.RefDraftPath)
+	d.Set("locked", obj.Locked)
+	d.Set("lock_comments", obj.LockComments)
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallDraftUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Draft ID")
+	}
+
+	log.Printf("[INFO] Updating Firewall Draft with ID %s", id)
+	err := policyFirewallDraftPatch(id, d, connector)
+	if err != nil {
+		return handleUpdateError("Firewall Draft", id, err)
+	}
+
+	return resourceNsxtPolicyFirewallDraftRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallDraftDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Draft ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDraftsClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Firewall Draft", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyFirewallDraftPublish() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallDraftPublishCreate,
+		Read:   resourceNsxtPolicyFirewallDraftPublishRead,
+		Delete: resourceNsxtPolicyFirewallDraftPublishDelete,
+
+		Schema: map[string]*schema.Schema{
+			"draft_path": getPolicyPathSchema(true, true, "Policy path of the draft to publish"),
+			"draft_revision": {
+				Type:        schema.TypeInt,
+				Description: "Revision of the draft to publish. Change in revision triggers publish of the draft",
+				Optional:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyFirewallDraftPublishCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	draftPath := d.Get("draft_path").(string)
+	draftID := getPolicyIDFromPath(draftPath)
+
+	// Publish draft as is, without additional changes on top of it
+	infraType := "Infra"
+	obj := model.Infra{
+		ResourceType: &infraType,
+	}
+
+	log.Printf("[INFO] Publishing Firewall Draft %s", draftPath)
+	client := infra.NewDraftsClient(connector)
+	err := client.Publish(draftID, obj)
+	if err != nil {
+		return handleCreateError("Firewall Draft Publish", draftID, err)
+	}
+
+	d.SetId(newUUID())
+
+	return resourceNsxtPolicyFirewallDraftPublishRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallDraftPublishRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Draft Publish ID")
+	}
+
+	// Publish is a one-time operation, and there is no NSX object to
+	// synchronize with. The draft itself may be removed after publish
+	// without affecting the published configuration.
+	return nil
+}
+
+func resourceNsxtPolicyFirewallDraftPublishDelete(d *schema.ResourceData, m interface{}) error {
+	// Published configuration can not be reverted by this resource.
+	// In order to roll back, a previous draft should be published.
+	return nil
+}
@@ -0,0 +1,227 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyFirewallDraftCreateAttributes = map[string]string{
+	"display_name":  getAccTestResourceName(),
+	"description":   "terraform created",
+	"locked":        "false",
+	"lock_comments": "",
+}
+
+var accTestPolicyFirewallDraftUpdateAttributes = map[string]string{
+	"display_name":  getAccTestResourceName(),
+	"description":   "terraform updated",
+	"locked":        "true",
+	"lock_comments": "under review",
+}
+
+func TestAccResourceNsxtPolicyFirewallDraft_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_firewall_draft.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallDraftCheckDestroy(state, accTestPolicyFirewallDraftUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallDraftTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallDraftExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyFirewallDraftCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyFirewallDraftCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "locked", accTestPolicyFirewallDraftCreateAttributes["locked"]),
+					resource.TestCheckResourceAttr(testResourceName, "security_policy_paths.#", "1"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallDraftTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallDraftExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyFirewallDraftUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyFirewallDraftUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "locked", accTestPolicyFirewallDraftUpdateAttributes["locked"]),
+					resource.TestCheckResourceAttr(testResourceName, "lock_comments", accTestPolicyFirewallDraftUpdateAttributes["lock_comments"]),
+					resource.TestCheckResourceAttr(testResourceName, "security_policy_paths.#", "1"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallDraftMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallDraftExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "locked", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallDraft_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_draft.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallDraftCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallDraftMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallDraft_publish(t *testing.T) {
+	testResourceName := "nsxt_policy_firewall_draft_publish.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallDraftCheckDestroy(state, accTestPolicyFirewallDraftCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallDraftPublishTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallDraftExists("nsxt_policy_firewall_draft.test"),
+					resource.TestCheckResourceAttrPair(testResourceName, "draft_path", "nsxt_policy_firewall_draft.test", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "draft_revision", "nsxt_policy_firewall_draft.test", "revision"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallDraftExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Firewall Draft resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Firewall Draft resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyFirewallDraftExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Firewall Draft %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyFirewallDraftCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_firewall_draft" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyFirewallDraftExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Firewall Draft %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyFirewallDraftPolicyTemplate() string {
+	return `
+resource "nsxt_policy_security_policy" "test" {
+  display_name = "terraform-draft-policy"
+  category     = "Application"
+
+  rule {
+    display_name = "rule1"
+    action       = "DROP"
+  }
+}`
+}
+
+func testAccNsxtPolicyFirewallDraftTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyFirewallDraftCreateAttributes
+	} else {
+		attrMap = accTestPolicyFirewallDraftUpdateAttributes
+	}
+	return testAccNsxtPolicyFirewallDraftPolicyTemplate() + fmt.Sprintf(`
+resource "nsxt_policy_firewall_draft" "test" {
+  display_name          = "%s"
+  description           = "%s"
+  locked                = %s
+  lock_comments         = "%s"
+  security_policy_paths = [nsxt_policy_security_policy.test.path]
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["locked"], attrMap["lock_comments"])
+}
+
+func testAccNsxtPolicyFirewallDraftMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_draft" "test" {
+  display_name = "%s"
+}`, accTestPolicyFirewallDraftUpdateAttributes["display_name"])
+}
+
+func testAccNsxtPolicyFirewallDraftPublishTemplate() string {
+	return testAccNsxtPolicyFirewallDraftTemplate(true) + `
+
+resource "nsxt_policy_firewall_draft_publish" "test" {
+  draft_path     = nsxt_policy_firewall_draft.test.path
+  draft_revision = nsxt_policy_firewall_draft.test.revision
+}`
+}
@@ -0,0 +1,177 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyFirewallExcludeListMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallExcludeListMemberCreate,
+		Read:   resourceNsxtPolicyFirewallExcludeListMemberRead,
+		Delete: resourceNsxtPolicyFirewallExcludeListMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyFirewallExcludeListMemberImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"member": getPolicyPathSchema(true, true, "Policy path of Group, Segment or Segment Port to be excluded from firewall"),
+		},
+	}
+}
+
+func isPolicyFirewallExcludeListMember(list model.PolicyExcludeList, member string) bool {
+	for _, existing := range list.Members {
+		if existing == member {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyFirewallExcludeListInfraPatch(list model.PolicyExcludeList, connector *client.RestConnector) error {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	resourceType := "PolicyExcludeList"
+	list.ResourceType = &resourceType
+	childList := model.ChildPolicyExcludeList{
+		ResourceType:      "ChildPolicyExcludeList",
+		PolicyExcludeList: &list,
+	}
+
+	dataValue, errors := converter.ConvertToVapi(childList, model.ChildPolicyExcludeListBindingType())
+	if len(errors) > 0 {
+		return errors[0]
+	}
+
+	infraType := "Infra"
+	infraObj := model.Infra{
+		Children:     []*data.StructValue{dataValue.(*data.StructValue)},
+		ResourceType: &infraType,
+	}
+
+	// Revision is enforced so that concurrent modifications of the list
+	// are detected and retried rather than overwritten
+	return policyInfraPatch(infraObj, false, connector, true)
+}
+
+func updatePolicyFirewallExcludeListMember(connector *client.RestConnector, member string, isAdd bool) error {
+	client := security.NewExcludeListClient(connector)
+	return retryUponTransientAPIError(func() error {
+		list, err := client.Get()
+		if err != nil {
+			return err
+		}
+
+		members := make([]string, 0, len(list.Members))
+		for _, existing := range list.Members {
+			if existing != member {
+				members = append(members, existing)
+			}
+		}
+
+		if isAdd {
+			members = append(members, member)
+		} else if len(members) == len(list.Members) {
+			// Member is already removed
+			return nil
+		}
+
+		list.Members = members
+		return policyFirewallExcludeListInfraPatch(list, connector)
+	})
+}
+
+func resourceNsxtPolicyFirewallExcludeListMemberCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	member := d.Get("member").(string)
+
+	client := security.NewExcludeListClient(connector)
+	list, err := client.Get()
+	if err != nil {
+		return handleCreateError("Firewall Exclude List Member", member, err)
+	}
+	if isPolicyFirewallExcludeListMember(list, member) {
+		return fmt.Errorf("%s is already a member of Firewall Exclude List", member)
+	}
+
+	log.Printf("[INFO] Adding %s to Firewall Exclude List", member)
+	err = updatePolicyFirewallExcludeListMember(connector, member, true)
+	if err != nil {
+		return handleCreateError("Firewall Exclude List Member", member, err)
+	}
+
+	d.SetId(member)
+
+	return resourceNsxtPolicyFirewallExcludeListMemberRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallExcludeListMemberRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	member := d.Id()
+	if member == "" {
+		return fmt.Errorf("Error obtaining Firewall Exclude List Member")
+	}
+
+	client := security.NewExcludeListClient(connector)
+	list, err := client.Get()
+	if err != nil {
+		return handleReadError(d, "Firewall Exclude List Member", member, err)
+	}
+
+	if !isPolicyFirewallExcludeListMember(list, member) {
+		log.Printf("[DEBUG] %s is not a member of Firewall Exclude List", member)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("member", member)
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallExcludeListMemberDelete(d *schema.ResourceData, m interface{}) error {
+	member := d.Id()
+	if member == "" {
+		return fmt.Errorf("Error obtaining Firewall Exclude List Member")
+	}
+
+	log.Printf("[INFO] Removing %s from Firewall Exclude List", member)
+	err := updatePolicyFirewallExcludeListMember(getPolicyConnector(m), member, false)
+	if err != nil {
+		return handleDeleteError("Firewall Exclude List Member", member, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallExcludeListMemberImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	member := d.Id()
+	if !isPolicyPath(member) {
+		return nil, fmt.Errorf("Please provide policy path of the excluded object as an input")
+	}
+
+	d.Set("member", member)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,139 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security"
+)
+
+func TestAccResourceNsxtPolicyFirewallExcludeListMember_basic(t *testing.T) {
+	groupResourceName := "nsxt_policy_firewall_exclude_list_member.group"
+	segmentResourceName := "nsxt_policy_firewall_exclude_list_member.segment"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallExcludeListMemberCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallExcludeListMemberTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallExcludeListMemberExists(groupResourceName),
+					resource.TestCheckResourceAttrPair(groupResourceName, "member", "nsxt_policy_group.test", "path"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallExcludeListMemberTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallExcludeListMemberExists(groupResourceName),
+					testAccNsxtPolicyFirewallExcludeListMemberExists(segmentResourceName),
+					resource.TestCheckResourceAttrPair(groupResourceName, "member", "nsxt_policy_group.test", "path"),
+					resource.TestCheckResourceAttrPair(segmentResourceName, "member", "nsxt_policy_segment.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallExcludeListMember_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_firewall_exclude_list_member.group"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallExcludeListMemberCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallExcludeListMemberTemplate(false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallExcludeListMemberExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Firewall Exclude List Member resource %s not found in resources", resourceName)
+		}
+
+		member := rs.Primary.ID
+		if member == "" {
+			return fmt.Errorf("Policy Firewall Exclude List Member resource ID not set in resources")
+		}
+
+		client := security.NewExcludeListClient(connector)
+		list, err := client.Get()
+		if err != nil {
+			return err
+		}
+		if !isPolicyFirewallExcludeListMember(list, member) {
+			return fmt.Errorf("%s is not a member of Policy Firewall Exclude List", member)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyFirewallExcludeListMemberCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	client := security.NewExcludeListClient(connector)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_firewall_exclude_list_member" {
+			continue
+		}
+
+		list, err := client.Get()
+		if err != nil {
+			return err
+		}
+
+		if isPolicyFirewallExcludeListMember(list, rs.Primary.ID) {
+			return fmt.Errorf("%s is still a member of Policy Firewall Exclude List", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyFirewallExcludeListMemberTemplate(withSegment bool) string {
+	config := testAccNsxtPolicyGatewayFabricDeps(false) + `
+resource "nsxt_policy_group" "test" {
+  display_name = "terraform-exclude-list-group"
+}
+
+resource "nsxt_policy_segment" "test" {
+  display_name        = "terraform-exclude-list-segment"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+}
+
+resource "nsxt_policy_firewall_exclude_list_member" "group" {
+  member = nsxt_policy_group.test.path
+}`
+	if withSegment {
+		config += `
+
+resource "nsxt_policy_firewall_exclude_list_member" "segment" {
+  member = nsxt_policy_segment.test.path
+}`
+	}
+	return config
+}
@@ -0,0 +1,185 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// System-owned thresholds profile that applies to all transport nodes
+// unless overridden by a binding. Thresholds configured by this resource
+// modify this shared profile.
+const policyFirewallDefaultCPUMemThresholdsProfileID = "default"
+
+func resourceNsxtPolicyFirewallGlobalConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallGlobalConfigCreate,
+		Read:   resourceNsxtPolicyFirewallGlobalConfigRead,
+		Update: resourceNsxtPolicyFirewallGlobalConfigUpdate,
+		Delete: resourceNsxtPolicyFirewallGlobalConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"enable_firewall": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable Distributed Firewall",
+				Optional:    true,
+				Computed:    true,
+			},
+			"disable_auto_drafts": {
+				Type:        schema.TypeBool,
+				Description: "Flag to disable auto drafts",
+				Optional:    true,
+				Computed:    true,
+			},
+			"cpu_threshold_percentage": {
+				Type:         schema.TypeInt,
+				Description:  "CPU utilization threshold percentage for firewall on transport nodes",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"mem_threshold_percentage": {
+				Type:         schema.TypeInt,
+				Description:  "Memory utilization threshold percentage for firewall on transport nodes",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+		},
+	}
+}
+
+// Returns true if the flag is set in configuration on create, or changed on update
+func isPolicyFirewallGlobalConfigFlagSet(d *schema.ResourceData, attrName string) bool {
+	_, isSet := d.GetOkExists(attrName)
+	return isSet && (d.IsNewResource() || d.HasChange(attrName))
+}
+
+func policyFirewallGlobalConfigPatch(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Only settings present in configuration are sent, so that settings
+	// managed outside of terraform, such as disabled firewall, are preserved
+	obj := model.DfwFirewallConfiguration{
+		ResourceType: model.DfwFirewallConfiguration__TYPE_IDENTIFIER,
+	}
+	if isPolicyFirewallGlobalConfigFlagSet(d, "enable_firewall") {
+		enableFirewall := d.Get("enable_firewall").(bool)
+		obj.EnableFirewall = &enableFirewall
+	}
+	if isPolicyFirewallGlobalConfigFlagSet(d, "disable_auto_drafts") {
+		disableAutoDrafts := d.Get("disable_auto_drafts").(bool)
+		obj.DisableAutoDrafts = &disableAutoDrafts
+	}
+
+	if obj.EnableFirewall != nil || obj.DisableAutoDrafts != nil {
+		client := firewall.NewSecurityClient(connector)
+		err := client.Patch(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !d.HasChange("cpu_threshold_percentage") && !d.HasChange("mem_threshold_percentage") {
+		return nil
+	}
+
+	profileObj := model.PolicyFirewallCpuMemThresholdsProfile{}
+	if cpuThreshold, ok := d.GetOk("cpu_threshold_percentage"); ok {
+		value := int64(cpuThreshold.(int))
+		profileObj.CpuThresholdPercentage = &value
+	}
+	if memThreshold, ok := d.GetOk("mem_threshold_percentage"); ok {
+		value := int64(memThreshold.(int))
+		profileObj.MemThresholdPercentage = &value
+	}
+
+	profileClient := firewall.NewCpuMemThresholdsProfilesClient(connector)
+	return profileClient.Patch(policyFirewallDefaultCPUMemThresholdsProfileID, profileObj, nil)
+}
+
+func resourceNsxtPolicyFirewallGlobalConfigCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := newUUID()
+	log.Printf("[INFO] Updating Firewall Global Config")
+	err := policyFirewallGlobalConfigPatch(d, m)
+	if err != nil {
+		return handleCreateError("Firewall Global Config", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceNsxtPolicyFirewallGlobalConfigRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallGlobalConfigRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Global Config ID")
+	}
+
+	client := firewall.NewSecurityClient(connector)
+	obj, err := client.Get()
+	if err != nil {
+		return handleReadError(d, "Firewall Global Config", id, err)
+	}
+
+	d.Set("revision", obj.Revision)
+	d.Set("enable_firewall", obj.EnableFirewall)
+	d.Set("disable_auto_drafts", obj.DisableAutoDrafts)
+
+	profileClient := firewall.NewCpuMemThresholdsProfilesClient(connector)
+	profile, err := profileClient.Get(policyFirewallDefaultCPUMemThresholdsProfileID)
+	if err != nil {
+		// The profile is system owned, hence failure to read it should not
+		// remove the config from state
+		return fmt.Errorf("Error during Firewall CPU Memory Thresholds Profile %s read: %v", policyFirewallDefaultCPUMemThresholdsProfileID, err)
+	}
+
+	d.Set("cpu_threshold_percentage", profile.CpuThresholdPercentage)
+	d.Set("mem_threshold_percentage", profile.MemThresholdPercentage)
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallGlobalConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Global Config ID")
+	}
+
+	log.Printf("[INFO] Updating Firewall Global Config")
+	err := policyFirewallGlobalConfigPatch(d, m)
+	if err != nil {
+		return handleUpdateError("Firewall Global Config", id, err)
+	}
+
+	return resourceNsxtPolicyFirewallGlobalConfigRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallGlobalConfigDelete(d *schema.ResourceData, m interface{}) error {
+	// Global firewall settings can not be deleted. Reverting them to defaults
+	// might unexpectedly enable firewall on the system, hence no action is
+	// taken here and settings remain as configured.
+	return nil
+}
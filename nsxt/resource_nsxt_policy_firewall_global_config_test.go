@@ -0,0 +1,86 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtPolicyFirewallGlobalConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_firewall_global_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallGlobalConfigTemplate(true, 80),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enable_firewall", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "disable_auto_drafts", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "cpu_threshold_percentage", "80"),
+					resource.TestCheckResourceAttrSet(testResourceName, "mem_threshold_percentage"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				// Flags not in configuration keep their current NSX values
+				Config: testAccNsxtPolicyFirewallGlobalConfigThresholdOnlyTemplate(85),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enable_firewall", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "disable_auto_drafts", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "cpu_threshold_percentage", "85"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallGlobalConfigTemplate(false, 90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enable_firewall", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "disable_auto_drafts", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "cpu_threshold_percentage", "90"),
+					resource.TestCheckResourceAttrSet(testResourceName, "mem_threshold_percentage"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallGlobalConfig_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_firewall_global_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallGlobalConfigTemplate(false, 90),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallGlobalConfigTemplate(disableAutoDrafts bool, cpuThreshold int) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_global_config" "test" {
+  enable_firewall          = true
+  disable_auto_drafts      = %t
+  cpu_threshold_percentage = %d
+}`, disableAutoDrafts, cpuThreshold)
+}
+
+func testAccNsxtPolicyFirewallGlobalConfigThresholdOnlyTemplate(cpuThreshold int) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_global_config" "test" {
+  cpu_threshold_percentage = %d
+}`, cpuThreshold)
+}
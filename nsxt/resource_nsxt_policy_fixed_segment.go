@@ -12,10 +12,10 @@ import (
 
 func resourceNsxtPolicyFixedSegment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyFixedSegmentCreate,
-		Read:   resourceNsxtPolicyFixedSegmentRead,
-		Update: resourceNsxtPolicyFixedSegmentUpdate,
-		Delete: resourceNsxtPolicyFixedSegmentDelete,
+		CreateContext: nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyFixedSegmentCreate),
+		ReadContext:   nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyFixedSegmentRead),
+		UpdateContext: nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyFixedSegmentUpdate),
+		Delete:        resourceNsxtPolicyFixedSegmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtGatewayResourceImporter,
 		},
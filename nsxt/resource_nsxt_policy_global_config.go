@@ -0,0 +1,149 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyGlobalConfigL3ForwardingModeValues = []string{
+	model.GlobalConfig_L3FORWARDING_MODE_ONLY,
+	model.GlobalConfig_L3FORWARDING_MODE_AND_IPV6,
+}
+
+func resourceNsxtPolicyGlobalConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyGlobalConfigCreate,
+		Read:   resourceNsxtPolicyGlobalConfigRead,
+		Update: resourceNsxtPolicyGlobalConfigUpdate,
+		Delete: resourceNsxtPolicyGlobalConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"l3_forwarding_mode": {
+				Type:         schema.TypeString,
+				Description:  "Forwarding mode for routing",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(policyGlobalConfigL3ForwardingModeValues, false),
+			},
+			"mtu": {
+				Type:         schema.TypeInt,
+				Description:  "Global Maximum Transmission Unit (MTU) for logical routing",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1280),
+			},
+			"uplink_mtu_threshold": {
+				Type:         schema.TypeInt,
+				Description:  "Upper threshold for MTU value configured on physical or logical routing uplinks",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1280, 9000),
+			},
+		},
+	}
+}
+
+func policyGlobalConfigPatch(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	resourceType := "GlobalConfig"
+	obj := model.GlobalConfig{
+		ResourceType: &resourceType,
+	}
+
+	// Forwarding mode is only sent when configured, so that IPv6 forwarding
+	// enabled outside of terraform is not reverted
+	if mode, ok := d.GetOk("l3_forwarding_mode"); ok && (d.IsNewResource() || d.HasChange("l3_forwarding_mode")) {
+		value := mode.(string)
+		obj.L3ForwardingMode = &value
+	}
+
+	if mtu, ok := d.GetOk("mtu"); ok {
+		value := int64(mtu.(int))
+		obj.Mtu = &value
+	}
+
+	if threshold, ok := d.GetOk("uplink_mtu_threshold"); ok {
+		value := int64(threshold.(int))
+		obj.UplinkMtuThreshold = &value
+	}
+
+	client := infra.NewGlobalConfigClient(connector)
+	return client.Patch(obj)
+}
+
+func resourceNsxtPolicyGlobalConfigCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	id := newUUID()
+	log.Printf("[INFO] Updating Global Config")
+	err := policyGlobalConfigPatch(d, m)
+	if err != nil {
+		return handleCreateError("Global Config", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceNsxtPolicyGlobalConfigRead(d, m)
+}
+
+func resourceNsxtPolicyGlobalConfigRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Global Config ID")
+	}
+
+	client := infra.NewGlobalConfigClient(connector)
+	obj, err := client.Get()
+	if err != nil {
+		return handleReadError(d, "Global Config", id, err)
+	}
+
+	d.Set("revision", obj.Revision)
+	d.Set("l3_forwarding_mode", obj.L3ForwardingMode)
+	d.Set("mtu", obj.Mtu)
+	d.Set("uplink_mtu_threshold", obj.UplinkMtuThreshold)
+
+	return nil
+}
+
+func resourceNsxtPolicyGlobalConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Global Config ID")
+	}
+
+	log.Printf("[INFO] Updating Global Config")
+	err := policyGlobalConfigPatch(d, m)
+	if err != nil {
+		return handleUpdateError("Global Config", id, err)
+	}
+
+	return resourceNsxtPolicyGlobalConfigRead(d, m)
+}
+
+func resourceNsxtPolicyGlobalConfigDelete(d *schema.ResourceData, m interface{}) error {
+	// Global config can not be deleted, and reverting MTU settings might
+	// conflict with existing uplink profiles, hence no action is taken here
+	return nil
+}
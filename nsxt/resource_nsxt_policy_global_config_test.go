@@ -0,0 +1,82 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtPolicyGlobalConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_global_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGlobalConfigTemplate("IPV4_AND_IPV6", 1600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "l3_forwarding_mode", "IPV4_AND_IPV6"),
+					resource.TestCheckResourceAttr(testResourceName, "mtu", "1600"),
+					resource.TestCheckResourceAttrSet(testResourceName, "uplink_mtu_threshold"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				// Forwarding mode not in configuration keeps its current NSX value
+				Config: testAccNsxtPolicyGlobalConfigMTUOnlyTemplate(1550),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "l3_forwarding_mode", "IPV4_AND_IPV6"),
+					resource.TestCheckResourceAttr(testResourceName, "mtu", "1550"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGlobalConfigTemplate("IPV4_ONLY", 1500),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "l3_forwarding_mode", "IPV4_ONLY"),
+					resource.TestCheckResourceAttr(testResourceName, "mtu", "1500"),
+					resource.TestCheckResourceAttrSet(testResourceName, "uplink_mtu_threshold"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyGlobalConfig_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_global_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGlobalConfigTemplate("IPV4_ONLY", 1500),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGlobalConfigTemplate(mode string, mtu int) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_global_config" "test" {
+  l3_forwarding_mode = "%s"
+  mtu                = %d
+}`, mode, mtu)
+}
+
+func testAccNsxtPolicyGlobalConfigMTUOnlyTemplate(mtu int) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_global_config" "test" {
+  mtu = %d
+}`, mtu)
+}
@@ -6,6 +6,7 @@ package nsxt
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -44,6 +45,8 @@ var conjunctionOperatorValues = []string{
 	model.ConjunctionOperator_CONJUNCTION_OPERATOR_AND,
 }
 
+var policyGroupPathPattern = regexp.MustCompile("^/infra/domains/[^/]+/groups/[^/]+$")
+
 func resourceNsxtPolicyGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtPolicyGroupCreate,
@@ -51,7 +54,7 @@ func resourceNsxtPolicyGroup() *schema.Resource {
 		Update: resourceNsxtPolicyGroupUpdate,
 		Delete: resourceNsxtPolicyGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtDomainResourceImporter,
+			State: nsxtDomainMPPromotedResourceImporter(policyGroupPathPattern),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -0,0 +1,451 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/livetraces"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyLiveTraceProtocolValues = []string{
+	model.TransportInfo_PROTOCOL_TCP,
+	model.TransportInfo_PROTOCOL_UDP,
+}
+
+func resourceNsxtPolicyLiveTrace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLiveTraceCreate,
+		Read:   resourceNsxtPolicyLiveTraceRead,
+		Delete: resourceNsxtPolicyLiveTraceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		// NOTE: livetrace session runs once on create, hence all arguments
+		// force new resource
+		Schema: map[string]*schema.Schema{
+			"nsx_id": getNsxIDSchema(),
+			"path":   getPathSchema(),
+			"source_segment_port_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of segment port to observe live traffic on",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"destination_segment_port_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of segment port to observe reverse traffic on, which makes the session bidirectional",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"trace": {
+				Type:        schema.TypeBool,
+				Description: "Whether to trace filtered packets",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+			"packet_capture": {
+				Type:        schema.TypeBool,
+				Description: "Whether to capture filtered packets",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"sample_count": {
+				Type:         schema.TypeInt,
+				Description:  "Number of first matching packets to trace or capture",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"source_ip": {
+				Type:         schema.TypeString,
+				Description:  "Filter packets by source IP address",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"destination_ip": {
+				Type:         schema.TypeString,
+				Description:  "Filter packets by destination IP address",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Description:  "Filter packets by transport protocol",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(policyLiveTraceProtocolValues, false),
+			},
+			"source_port": {
+				Type:         schema.TypeInt,
+				Description:  "Filter packets by source port",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Description:  "Filter packets by destination port",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Duration in seconds for observing live traffic",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(5, 300),
+			},
+			"operation_state": {
+				Type:        schema.TypeString,
+				Description: "Livetrace session operation state",
+				Computed:    true,
+			},
+			"request_status": {
+				Type:        schema.TypeString,
+				Description: "Status of livetrace request delivery to transport nodes",
+				Computed:    true,
+			},
+			"trace_result": {
+				Type:        schema.TypeList,
+				Description: "Trace results per traced packet",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"packet_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the traced packet",
+							Computed:    true,
+						},
+						"direction": {
+							Type:        schema.TypeString,
+							Description: "Direction of the traced packet",
+							Computed:    true,
+						},
+						"delivered_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of delivered observations",
+							Computed:    true,
+						},
+						"dropped_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of dropped observations",
+							Computed:    true,
+						},
+						"analysis": {
+							Type:        schema.TypeList,
+							Description: "Trace result analysis notes",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"capture_file": {
+				Type:        schema.TypeList,
+				Description: "Packet capture files",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"transport_node_id": {
+							Type:        schema.TypeString,
+							Description: "ID of transport node where packets were captured",
+							Computed:    true,
+						},
+						"port_id": {
+							Type:        schema.TypeString,
+							Description: "ID of port where packets were captured",
+							Computed:    true,
+						},
+						"download_url": {
+							Type:        schema.TypeString,
+							Description: "URL to download capture file from",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyLiveTraceFilter(sourceIP string, destinationIP string, protocol string, sourcePort int64, destinationPort int64) (*data.StructValue, error) {
+	filter := model.FieldsFilterData{
+		IpInfo:        &model.IpInfo{},
+		TransportInfo: &model.TransportInfo{},
+		ResourceType:  model.FieldsFilterData__TYPE_IDENTIFIER,
+	}
+	if sourceIP != "" {
+		filter.IpInfo.SrcIp = &sourceIP
+	}
+	if destinationIP != "" {
+		filter.IpInfo.DstIp = &destinationIP
+	}
+	if protocol != "" {
+		filter.TransportInfo.Protocol = &protocol
+	}
+	if sourcePort > 0 {
+		filter.TransportInfo.SrcPort = &sourcePort
+	}
+	if destinationPort > 0 {
+		filter.TransportInfo.DstPort = &destinationPort
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	dataValue, errs := converter.ConvertToVapi(filter, model.FieldsFilterDataBindingType())
+	if errs != nil {
+		return nil, errs[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func getPolicyLiveTraceActionsFromSchema(d *schema.ResourceData) (*model.PolicyLiveTraceActionConfig, error) {
+	if !d.Get("trace").(bool) && !d.Get("packet_capture").(bool) {
+		return nil, fmt.Errorf("At least one of trace or packet_capture should be enabled for livetrace")
+	}
+
+	sampleCount := int64(d.Get("sample_count").(int))
+	traceType := model.LiveTracePacketGranularActionConfig_TRACE_TYPE_UNI_DIRECTIONAL
+	var reverseFilter *data.StructValue
+	destinationPortPath := d.Get("destination_segment_port_path").(string)
+	if destinationPortPath != "" {
+		// Reverse direction filter matches replies, hence source and
+		// destination are swapped
+		var err error
+		traceType = model.LiveTracePacketGranularActionConfig_TRACE_TYPE_BI_DIRECTIONAL
+		reverseFilter, err = getPolicyLiveTraceFilter(d.Get("destination_ip").(string), d.Get("source_ip").(string),
+			d.Get("protocol").(string), int64(d.Get("destination_port").(int)), int64(d.Get("source_port").(int)))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	getActionConfig := func() *model.LiveTracePacketGranularActionConfig {
+		config := model.LiveTracePacketGranularActionConfig{
+			Sampling: &model.LiveTraceSamplingConfig{
+				MatchNumber: &sampleCount,
+			},
+			TraceType:     &traceType,
+			ReverseFilter: reverseFilter,
+		}
+		if destinationPortPath != "" {
+			config.DestPortPath = &destinationPortPath
+		}
+		return &config
+	}
+
+	actions := model.PolicyLiveTraceActionConfig{}
+	if d.Get("trace").(bool) {
+		actions.TraceConfig = getActionConfig()
+	}
+	if d.Get("packet_capture").(bool) {
+		actions.PktcapConfig = getActionConfig()
+	}
+
+	return &actions, nil
+}
+
+func resourceNsxtPolicyLiveTraceExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewLivetracesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func waitForPolicyLiveTrace(connector *client.RestConnector, id string, timeout time.Duration) error {
+	client := livetraces.NewStatusClient(connector)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{model.LiveTraceStatus_OPERATION_STATE_IN_PROGRESS},
+		Target: []string{
+			model.LiveTraceStatus_OPERATION_STATE_FINISHED,
+			model.LiveTraceStatus_OPERATION_STATE_PARTIAL_FINISHED,
+			model.LiveTraceStatus_OPERATION_STATE_CANCELED,
+			model.LiveTraceStatus_OPERATION_STATE_TIMEOUT,
+		},
+		Refresh: func() (interface{}, string, error) {
+			status, err := client.Get(id, nil)
+			if err != nil {
+				return nil, "", logAPIError("Error while querying livetrace status", err)
+			}
+
+			if status.OperationState == nil {
+				return status, model.LiveTraceStatus_OPERATION_STATE_IN_PROGRESS, nil
+			}
+
+			log.Printf("[DEBUG] Livetrace %s operation state: %s", id, *status.OperationState)
+			return status, *status.OperationState, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+		Delay:      1 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func setPolicyLiveTraceResultsInSchema(d *schema.ResourceData, connector *client.RestConnector, id string) error {
+	client := livetraces.NewResultClient(connector)
+	result, err := client.Get(id, nil)
+	if err != nil {
+		return err
+	}
+
+	var traceList []map[string]interface{}
+	for _, trace := range result.TraceResults {
+		elem := make(map[string]interface{})
+		elem["packet_id"] = trace.PacketId
+		elem["direction"] = trace.Direction
+		elem["analysis"] = trace.Analysis
+		if trace.Counters != nil {
+			elem["delivered_count"] = trace.Counters.DeliveredCount
+			elem["dropped_count"] = trace.Counters.DroppedCount
+		}
+		traceList = append(traceList, elem)
+	}
+
+	var fileList []map[string]interface{}
+	for _, capture := range result.PktcapResults {
+		for _, file := range capture.PktcapResourceList {
+			elem := make(map[string]interface{})
+			elem["transport_node_id"] = capture.TransportNodeId
+			elem["port_id"] = file.PortId
+			elem["download_url"] = file.PktcapFileDownloadUrl
+			fileList = append(fileList, elem)
+		}
+	}
+
+	d.Set("trace_result", traceList)
+	return d.Set("capture_file", fileList)
+}
+
+func resourceNsxtPolicyLiveTraceCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLiveTraceExists)
+	if err != nil {
+		return err
+	}
+
+	actions, err := getPolicyLiveTraceActionsFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	filter, err := getPolicyLiveTraceFilter(d.Get("source_ip").(string), d.Get("destination_ip").(string),
+		d.Get("protocol").(string), int64(d.Get("source_port").(int)), int64(d.Get("destination_port").(int)))
+	if err != nil {
+		return err
+	}
+
+	sourcePortPath := d.Get("source_segment_port_path").(string)
+	timeout := int64(d.Get("timeout").(int))
+	isTransient := false
+	obj := model.LiveTraceConfig{
+		SrcPortPath: &sourcePortPath,
+		Actions:     actions,
+		Filter:      filter,
+		Timeout:     &timeout,
+		IsTransient: &isTransient,
+	}
+
+	log.Printf("[INFO] Starting Livetrace with ID %s", id)
+	client := infra.NewLivetracesClient(connector)
+	err = client.Patch(id, obj)
+	if err != nil {
+		return handleCreateError("Livetrace", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	err = waitForPolicyLiveTrace(connector, id, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtPolicyLiveTraceRead(d, m)
+}
+
+func resourceNsxtPolicyLiveTraceRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Livetrace ID")
+	}
+
+	config, err := infra.NewLivetracesClient(connector).Get(id)
+	if err != nil {
+		return handleReadError(d, "Livetrace", id, err)
+	}
+	d.Set("nsx_id", id)
+	d.Set("path", config.Path)
+
+	status, err := livetraces.NewStatusClient(connector).Get(id, nil)
+	if err != nil {
+		return handleReadError(d, "Livetrace Status", id, err)
+	}
+	d.Set("operation_state", status.OperationState)
+	d.Set("request_status", status.RequestStatus)
+
+	err = setPolicyLiveTraceResultsInSchema(d, connector, id)
+	if err != nil {
+		return handleReadError(d, "Livetrace Result", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyLiveTraceDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Livetrace ID")
+	}
+
+	client := infra.NewLivetracesClient(getPolicyConnector(m))
+	err := client.Delete(id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return handleDeleteError("Livetrace", id, err)
+	}
+
+	return nil
+}
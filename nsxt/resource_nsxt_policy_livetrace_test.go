@@ -0,0 +1,90 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyLiveTrace_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_livetrace.test"
+	portPath := os.Getenv("NSXT_TEST_SEGMENT_PORT_PATH")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_SEGMENT_PORT_PATH")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLiveTraceCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLiveTraceTemplate(portPath, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyLiveTraceExists),
+					resource.TestCheckResourceAttr(testResourceName, "source_segment_port_path", portPath),
+					resource.TestCheckResourceAttr(testResourceName, "trace", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "packet_capture", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "operation_state"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLiveTraceTemplate(portPath, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyLiveTraceExists),
+					resource.TestCheckResourceAttr(testResourceName, "packet_capture", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "protocol", "TCP"),
+					resource.TestCheckResourceAttr(testResourceName, "destination_port", "443"),
+					resource.TestCheckResourceAttrSet(testResourceName, "operation_state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLiveTraceCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_livetrace" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLiveTraceExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Livetrace %s still exists", resourceID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLiveTraceTemplate(portPath string, capture bool) string {
+	filter := ""
+	if capture {
+		filter = `
+  packet_capture   = true
+  protocol         = "TCP"
+  destination_port = 443`
+	}
+
+	return fmt.Sprintf(`
+resource "nsxt_policy_livetrace" "test" {
+  source_segment_port_path = "%s"
+  timeout                  = 5%s
+}`, portPath, filter)
+}
@@ -0,0 +1,226 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var metadataProxyCryptoProtocolsValues = []string{
+	model.MetadataProxyConfig_CRYPTO_PROTOCOLS_V1,
+	model.MetadataProxyConfig_CRYPTO_PROTOCOLS_V1_1,
+	model.MetadataProxyConfig_CRYPTO_PROTOCOLS_V1_2,
+}
+
+func resourceNsxtPolicyMetadataProxy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyMetadataProxyCreate,
+		Read:   resourceNsxtPolicyMetadataProxyRead,
+		Update: resourceNsxtPolicyMetadataProxyUpdate,
+		Delete: resourceNsxtPolicyMetadataProxyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":            getNsxIDSchema(),
+			"path":              getPathSchema(),
+			"display_name":      getDisplayNameSchema(),
+			"description":       getDescriptionSchema(),
+			"revision":          getRevisionSchema(),
+			"tag":               getTagsSchema(),
+			"edge_cluster_path": getPolicyPathSchema(true, false, "Policy path to Edge Cluster"),
+			"server_address": {
+				Type:         schema.TypeString,
+				Description:  "Metadata server URL, in format http://<ip>:<port>/<path>",
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Description: "Secret word or phrase to access metadata server",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"crypto_protocols": {
+				Type:        schema.TypeList,
+				Description: "Cryptographic protocols supported by the metadata proxy",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(metadataProxyCryptoProtocolsValues, false),
+				},
+			},
+			"server_certificates": {
+				Type:        schema.TypeList,
+				Description: "Policy paths to server certificates",
+				Optional:    true,
+				Elem:        getElemPolicyPathSchema(),
+			},
+			"preferred_edge_paths": {
+				Type:        schema.TypeList,
+				Description: "Policy paths to preferred Edge Nodes, which should be members of the Edge Cluster",
+				Optional:    true,
+				Elem:        getElemPolicyPathSchema(),
+			},
+			"enable_standby_relocation": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable standby relocation for auto-placed metadata proxy",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyMetadataProxyExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewMetadataProxiesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func policyMetadataProxyPatch(id string, d *schema.ResourceData, connector *client.RestConnector) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	edgeClusterPath := d.Get("edge_cluster_path").(string)
+	serverAddress := d.Get("server_address").(string)
+	secret := d.Get("secret").(string)
+	cryptoProtocols := getStringListFromSchemaList(d, "crypto_protocols")
+	serverCertificates := getStringListFromSchemaList(d, "server_certificates")
+	preferredEdgePaths := getStringListFromSchemaList(d, "preferred_edge_paths")
+	enableStandbyRelocation := d.Get("enable_standby_relocation").(bool)
+
+	obj := model.MetadataProxyConfig{
+		DisplayName:             &displayName,
+		Description:             &description,
+		Tags:                    tags,
+		EdgeClusterPath:         &edgeClusterPath,
+		ServerAddress:           &serverAddress,
+		ServerCertificates:      serverCertificates,
+		PreferredEdgePaths:      preferredEdgePaths,
+		EnableStandbyRelocation: &enableStandbyRelocation,
+	}
+
+	if len(secret) > 0 {
+		obj.Secret = &secret
+	}
+
+	if len(cryptoProtocols) > 0 {
+		obj.CryptoProtocols = cryptoProtocols
+	}
+
+	client := infra.NewMetadataProxiesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyMetadataProxyCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyMetadataProxyExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Metadata Proxy with ID %s", id)
+	err = policyMetadataProxyPatch(id, d, connector)
+	if err != nil {
+		return handleCreateError("Metadata Proxy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyMetadataProxyRead(d, m)
+}
+
+func resourceNsxtPolicyMetadataProxyRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Metadata Proxy ID")
+	}
+
+	client := infra.NewMetadataProxiesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Metadata Proxy", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	// NOTE: secret is not returned on API responses
+	d.Set("edge_cluster_path", obj.EdgeClusterPath)
+	d.Set("server_address", obj.ServerAddress)
+	d.Set("crypto_protocols", obj.CryptoProtocols)
+	d.Set("server_certificates", obj.ServerCertificates)
+	d.Set("preferred_edge_paths", obj.PreferredEdgePaths)
+	d.Set("enable_standby_relocation", obj.EnableStandbyRelocation)
+
+	return nil
+}
+
+func resourceNsxtPolicyMetadataProxyUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Metadata Proxy ID")
+	}
+
+	log.Printf("[INFO] Updating Metadata Proxy with ID %s", id)
+	err := policyMetadataProxyPatch(id, d, connector)
+	if err != nil {
+		return handleUpdateError("Metadata Proxy", id, err)
+	}
+
+	return resourceNsxtPolicyMetadataProxyRead(d, m)
+}
+
+func resourceNsxtPolicyMetadataProxyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Metadata Proxy ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewMetadataProxiesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Metadata Proxy", id, err)
+	}
+
+	return nil
+}
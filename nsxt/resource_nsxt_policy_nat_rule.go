@@ -37,10 +37,10 @@ var policyNATRuleFirewallMatchTypeValues = []string{
 
 func resourceNsxtPolicyNATRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyNATRuleCreate,
-		Read:   resourceNsxtPolicyNATRuleRead,
-		Update: resourceNsxtPolicyNATRuleUpdate,
-		Delete: resourceNsxtPolicyNATRuleDelete,
+		CreateContext: nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyNATRuleCreate),
+		ReadContext:   nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyNATRuleRead),
+		UpdateContext: nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyNATRuleUpdate),
+		Delete:        resourceNsxtPolicyNATRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyNATRuleImport,
 		},
@@ -6,6 +6,7 @@ package nsxt
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -15,6 +16,8 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
+var policySecurityPolicyPathPattern = regexp.MustCompile("^/infra/domains/[^/]+/security-policies/[^/]+$")
+
 func resourceNsxtPolicySecurityPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNsxtPolicySecurityPolicyCreate,
@@ -22,7 +25,7 @@ func resourceNsxtPolicySecurityPolicy() *schema.Resource {
 		Update: resourceNsxtPolicySecurityPolicyUpdate,
 		Delete: resourceNsxtPolicySecurityPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtDomainResourceImporter,
+			State: nsxtDomainMPPromotedResourceImporter(policySecurityPolicyPathPattern),
 		},
 		Schema: getPolicySecurityPolicySchema(false),
 	}
@@ -4,17 +4,21 @@
 package nsxt
 
 import (
+	"regexp"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+var policySegmentPathPattern = regexp.MustCompile("^/infra/segments/[^/]+$")
+
 func resourceNsxtPolicySegment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicySegmentCreate,
-		Read:   resourceNsxtPolicySegmentRead,
-		Update: resourceNsxtPolicySegmentUpdate,
-		Delete: resourceNsxtPolicySegmentDelete,
+		CreateContext: nsxtPolicyWithRealizedAlarms(resourceNsxtPolicySegmentCreate),
+		ReadContext:   nsxtPolicyWithRealizedAlarms(resourceNsxtPolicySegmentRead),
+		UpdateContext: nsxtPolicyWithRealizedAlarms(resourceNsxtPolicySegmentUpdate),
+		Delete:        resourceNsxtPolicySegmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyMPPromotedResourceImporter(policySegmentPathPattern),
 		},
 
 		Schema: getPolicyCommonSegmentSchema(false, false),
@@ -41,10 +41,10 @@ var policyBGPGracefulRestartStaleRouteTimerDefault = 600
 func resourceNsxtPolicyTier0Gateway() *schema.Resource {
 
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier0GatewayCreate,
-		Read:   resourceNsxtPolicyTier0GatewayRead,
-		Update: resourceNsxtPolicyTier0GatewayUpdate,
-		Delete: resourceNsxtPolicyTier0GatewayDelete,
+		CreateContext: nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyTier0GatewayCreate),
+		ReadContext:   nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyTier0GatewayRead),
+		UpdateContext: nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyTier0GatewayUpdate),
+		Delete:        resourceNsxtPolicyTier0GatewayDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -6,6 +6,7 @@ package nsxt
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -43,14 +44,16 @@ var poolAllocationValues = []string{
 	model.Tier1_POOL_ALLOCATION_LB_XLARGE,
 }
 
+var policyTier1GatewayPathPattern = regexp.MustCompile("^/infra/tier-1s/[^/]+$")
+
 func resourceNsxtPolicyTier1Gateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier1GatewayCreate,
-		Read:   resourceNsxtPolicyTier1GatewayRead,
-		Update: resourceNsxtPolicyTier1GatewayUpdate,
-		Delete: resourceNsxtPolicyTier1GatewayDelete,
+		CreateContext: nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyTier1GatewayCreate),
+		ReadContext:   nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyTier1GatewayRead),
+		UpdateContext: nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyTier1GatewayUpdate),
+		Delete:        resourceNsxtPolicyTier1GatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyMPPromotedResourceImporter(policyTier1GatewayPathPattern),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -0,0 +1,484 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/traceflows"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const (
+	policyTraceflowResultDelivered = "DELIVERED"
+	policyTraceflowResultDropped   = "DROPPED"
+	policyTraceflowResultUnknown   = "UNKNOWN"
+)
+
+var policyTraceflowProtocolNumbers = map[string]int64{
+	"ICMP": 1,
+	"TCP":  6,
+	"UDP":  17,
+}
+
+var policyTraceflowProtocolValues = []string{"ICMP", "TCP", "UDP"}
+
+func resourceNsxtPolicyTraceflow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyTraceflowCreate,
+		Read:   resourceNsxtPolicyTraceflowRead,
+		Delete: resourceNsxtPolicyTraceflowDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		// NOTE: traceflow runs once on create, hence all arguments
+		// force new resource
+		Schema: map[string]*schema.Schema{
+			"nsx_id": getNsxIDSchema(),
+			"path":   getPathSchema(),
+			"segment_port_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of segment port to inject traceflow packet from",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"source_ip": {
+				Type:         schema.TypeString,
+				Description:  "Source IP address of traceflow packet",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"source_mac": {
+				Type:        schema.TypeString,
+				Description: "Source MAC address of traceflow packet",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"destination_ip": {
+				Type:         schema.TypeString,
+				Description:  "Destination IP address of traceflow packet",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"destination_mac": {
+				Type:        schema.TypeString,
+				Description: "Destination MAC address of traceflow packet",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Description:  "Transport protocol of traceflow packet",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ICMP",
+				ValidateFunc: validation.StringInSlice(policyTraceflowProtocolValues, false),
+			},
+			"source_port": {
+				Type:         schema.TypeInt,
+				Description:  "Source port for TCP or UDP traceflow packet",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Description:  "Destination port for TCP or UDP traceflow packet",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"routed": {
+				Type:        schema.TypeBool,
+				Description: "Whether traceflow packet is routed",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum time in milliseconds NSX waits for traceflow round",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      10000,
+				ValidateFunc: validation.IntBetween(5000, 15000),
+			},
+			"result": {
+				Type:        schema.TypeString,
+				Description: "Traceflow result, one of DELIVERED, DROPPED or UNKNOWN",
+				Computed:    true,
+			},
+			"operation_state": {
+				Type:        schema.TypeString,
+				Description: "Traceflow operation state",
+				Computed:    true,
+			},
+			"delivered_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of delivered observations",
+				Computed:    true,
+			},
+			"dropped_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of dropped observations",
+				Computed:    true,
+			},
+			"drop_reason": {
+				Type:        schema.TypeString,
+				Description: "Reason traceflow packet was dropped",
+				Computed:    true,
+			},
+			"dropped_acl_rule_id": {
+				Type:        schema.TypeInt,
+				Description: "ID of firewall rule that dropped the packet",
+				Computed:    true,
+			},
+			"analysis": {
+				Type:        schema.TypeList,
+				Description: "Traceflow result analysis notes",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"observation": {
+				Type:        schema.TypeList,
+				Description: "Traceflow observations (hops)",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:        schema.TypeString,
+							Description: "Observation type",
+							Computed:    true,
+						},
+						"component_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the component that issued the observation",
+							Computed:    true,
+						},
+						"component_type": {
+							Type:        schema.TypeString,
+							Description: "Type of the component that issued the observation",
+							Computed:    true,
+						},
+						"transport_node_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the transport node that observed the packet",
+							Computed:    true,
+						},
+						"sequence_no": {
+							Type:        schema.TypeInt,
+							Description: "Sequence number of the observation",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyTraceflowPacketFromSchema(d *schema.ResourceData) (*data.StructValue, error) {
+	protocol := d.Get("protocol").(string)
+	protocolNumber := policyTraceflowProtocolNumbers[protocol]
+	destinationIP := d.Get("destination_ip").(string)
+	routed := d.Get("routed").(bool)
+	transportType := model.PacketData_TRANSPORT_TYPE_UNICAST
+
+	ipHeader := model.Ipv4Header{
+		DstIp:    &destinationIP,
+		Protocol: &protocolNumber,
+	}
+	if sourceIP := d.Get("source_ip").(string); sourceIP != "" {
+		ipHeader.SrcIp = &sourceIP
+	}
+
+	ethHeader := model.EthernetHeader{}
+	if sourceMac := d.Get("source_mac").(string); sourceMac != "" {
+		ethHeader.SrcMac = &sourceMac
+	}
+	if destinationMac := d.Get("destination_mac").(string); destinationMac != "" {
+		ethHeader.DstMac = &destinationMac
+	}
+
+	transportHeader := model.TransportProtocolHeader{}
+	sourcePort := int64(d.Get("source_port").(int))
+	destinationPort := int64(d.Get("destination_port").(int))
+	switch protocol {
+	case "TCP":
+		// SYN flag
+		tcpFlags := int64(2)
+		transportHeader.TcpHeader = &model.TcpHeader{
+			SrcPort:  &sourcePort,
+			DstPort:  &destinationPort,
+			TcpFlags: &tcpFlags,
+		}
+	case "UDP":
+		transportHeader.UdpHeader = &model.UdpHeader{
+			SrcPort: &sourcePort,
+			DstPort: &destinationPort,
+		}
+	default:
+		echoID := int64(0)
+		sequence := int64(0)
+		transportHeader.IcmpEchoRequestHeader = &model.IcmpEchoRequestHeader{
+			Id:       &echoID,
+			Sequence: &sequence,
+		}
+	}
+
+	packet := model.FieldsPacketData{
+		EthHeader:       &ethHeader,
+		IpHeader:        &ipHeader,
+		TransportHeader: &transportHeader,
+		Routed:          &routed,
+		TransportType:   &transportType,
+		ResourceType:    model.PacketData_RESOURCE_TYPE_FIELDSPACKETDATA,
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	dataValue, errs := converter.ConvertToVapi(packet, model.FieldsPacketDataBindingType())
+	if errs != nil {
+		return nil, errs[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func resourceNsxtPolicyTraceflowExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewTraceflowsClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func waitForPolicyTraceflow(connector *client.RestConnector, id string, timeout time.Duration) error {
+	client := traceflows.NewStatusClient(connector)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{model.Traceflow_OPERATION_STATE_IN_PROGRESS},
+		Target:  []string{model.Traceflow_OPERATION_STATE_FINISHED, model.Traceflow_OPERATION_STATE_FAILED},
+		Refresh: func() (interface{}, string, error) {
+			status, err := client.Get(id, nil)
+			if err != nil {
+				return nil, "", logAPIError("Error while querying traceflow status", err)
+			}
+
+			if status.OperationState == nil {
+				return status, model.Traceflow_OPERATION_STATE_IN_PROGRESS, nil
+			}
+
+			log.Printf("[DEBUG] Traceflow %s operation state: %s", id, *status.OperationState)
+			return status, *status.OperationState, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+		Delay:      1 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func setPolicyTraceflowObservationsInSchema(d *schema.ResourceData, connector *client.RestConnector, id string) error {
+	client := traceflows.NewObservationsClient(connector)
+	observations, err := client.List(id, nil)
+	if err != nil {
+		return err
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	var observationList []map[string]interface{}
+	dropReason := ""
+	droppedRuleID := int64(0)
+	for _, result := range observations.Results {
+		dataValue, errs := converter.ConvertToGolang(result, model.TraceflowObservationBindingType())
+		if errs != nil {
+			return errs[0]
+		}
+		observation := dataValue.(model.TraceflowObservation)
+
+		elem := make(map[string]interface{})
+		elem["resource_type"] = observation.ResourceType
+		elem["component_name"] = observation.ComponentName
+		elem["component_type"] = observation.ComponentType
+		elem["transport_node_name"] = observation.TransportNodeName
+		elem["sequence_no"] = observation.SequenceNo
+		observationList = append(observationList, elem)
+
+		var reason *string
+		var aclRuleID *int64
+		switch observation.ResourceType {
+		case model.TraceflowObservation_RESOURCE_TYPE_TRACEFLOWOBSERVATIONDROPPED:
+			dataValue, errs = converter.ConvertToGolang(result, model.TraceflowObservationDroppedBindingType())
+			if errs != nil {
+				return errs[0]
+			}
+			dropped := dataValue.(model.TraceflowObservationDropped)
+			reason = dropped.Reason
+			aclRuleID = dropped.AclRuleId
+		case model.TraceflowObservation_RESOURCE_TYPE_TRACEFLOWOBSERVATIONDROPPEDLOGICAL:
+			dataValue, errs = converter.ConvertToGolang(result, model.TraceflowObservationDroppedLogicalBindingType())
+			if errs != nil {
+				return errs[0]
+			}
+			dropped := dataValue.(model.TraceflowObservationDroppedLogical)
+			reason = dropped.Reason
+			aclRuleID = dropped.AclRuleId
+		default:
+			continue
+		}
+
+		if reason != nil {
+			dropReason = *reason
+		}
+		if aclRuleID != nil && dropReason == model.TraceflowObservationDropped_REASON_FW_RULE {
+			droppedRuleID = *aclRuleID
+		}
+	}
+
+	d.Set("drop_reason", dropReason)
+	d.Set("dropped_acl_rule_id", droppedRuleID)
+	return d.Set("observation", observationList)
+}
+
+func resourceNsxtPolicyTraceflowCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTraceflowExists)
+	if err != nil {
+		return err
+	}
+
+	packet, err := getPolicyTraceflowPacketFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	segmentPortPath := d.Get("segment_port_path").(string)
+	timeout := int64(d.Get("timeout").(int))
+	obj := model.TraceflowConfig{
+		SegmentPortPath: &segmentPortPath,
+		Packet:          packet,
+		Timeout:         &timeout,
+	}
+
+	log.Printf("[INFO] Starting Traceflow with ID %s", id)
+	client := infra.NewTraceflowsClient(connector)
+	err = client.Patch(id, obj)
+	if err != nil {
+		return handleCreateError("Traceflow", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	err = waitForPolicyTraceflow(connector, id, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtPolicyTraceflowRead(d, m)
+}
+
+func resourceNsxtPolicyTraceflowRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Traceflow ID")
+	}
+
+	config, err := infra.NewTraceflowsClient(connector).Get(id)
+	if err != nil {
+		if isNotFoundError(err) {
+			// Traceflow results might be purged by NSX, in which case
+			// results recorded on create are kept
+			log.Printf("[DEBUG] Traceflow %s not found, keeping recorded results", id)
+			return nil
+		}
+		return handleReadError(d, "Traceflow", id, err)
+	}
+	d.Set("nsx_id", id)
+	d.Set("path", config.Path)
+
+	status, err := traceflows.NewStatusClient(connector).Get(id, nil)
+	if err != nil {
+		return handleReadError(d, "Traceflow Status", id, err)
+	}
+
+	d.Set("operation_state", status.OperationState)
+	d.Set("analysis", status.Analysis)
+
+	result := policyTraceflowResultUnknown
+	deliveredCount := int64(0)
+	droppedCount := int64(0)
+	if status.Counters != nil {
+		if status.Counters.DeliveredCount != nil {
+			deliveredCount = *status.Counters.DeliveredCount
+		}
+		if status.Counters.DroppedCount != nil {
+			droppedCount = *status.Counters.DroppedCount
+		}
+	}
+	if droppedCount > 0 {
+		result = policyTraceflowResultDropped
+	} else if deliveredCount > 0 {
+		result = policyTraceflowResultDelivered
+	}
+	d.Set("delivered_count", deliveredCount)
+	d.Set("dropped_count", droppedCount)
+	d.Set("result", result)
+
+	err = setPolicyTraceflowObservationsInSchema(d, connector, id)
+	if err != nil {
+		return handleReadError(d, "Traceflow Observations", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyTraceflowDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Traceflow ID")
+	}
+
+	client := infra.NewTraceflowsClient(getPolicyConnector(m))
+	err := client.Delete(id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return handleDeleteError("Traceflow", id, err)
+	}
+
+	return nil
+}
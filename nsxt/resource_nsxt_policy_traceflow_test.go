@@ -0,0 +1,119 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyTraceflow_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_traceflow.test"
+	portPath := os.Getenv("NSXT_TEST_SEGMENT_PORT_PATH")
+	destinationIP := os.Getenv("NSXT_TEST_TRACEFLOW_DESTINATION_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_SEGMENT_PORT_PATH")
+			testAccEnvDefined(t, "NSXT_TEST_TRACEFLOW_DESTINATION_IP")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTraceflowCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTraceflowTemplate(portPath, destinationIP, "ICMP"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTraceflowExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "segment_port_path", portPath),
+					resource.TestCheckResourceAttr(testResourceName, "destination_ip", destinationIP),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "result"),
+					resource.TestCheckResourceAttrSet(testResourceName, "operation_state"),
+					resource.TestCheckResourceAttrSet(testResourceName, "observation.#"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTraceflowTemplate(portPath, destinationIP, "TCP"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTraceflowExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "protocol", "TCP"),
+					resource.TestCheckResourceAttr(testResourceName, "destination_port", "443"),
+					resource.TestCheckResourceAttrSet(testResourceName, "result"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTraceflowExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Traceflow resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Traceflow resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyTraceflowExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Traceflow %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyTraceflowCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_traceflow" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyTraceflowExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Traceflow %s still exists", resourceID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyTraceflowTemplate(portPath string, destinationIP string, protocol string) string {
+	ports := ""
+	if protocol != "ICMP" {
+		ports = `
+  source_port      = 50000
+  destination_port = 443`
+	}
+
+	return fmt.Sprintf(`
+resource "nsxt_policy_traceflow" "test" {
+  segment_port_path = "%s"
+  destination_ip    = "%s"
+  protocol          = "%s"%s
+}`, portPath, destinationIP, protocol, ports)
+}
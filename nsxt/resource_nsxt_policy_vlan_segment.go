@@ -13,10 +13,10 @@ func resourceNsxtPolicyVlanSegment() *schema.Resource {
 	delete(segSchema, "connectivity_path")
 
 	return &schema.Resource{
-		Create: resourceNsxtPolicyVlanSegmentCreate,
-		Read:   resourceNsxtPolicyVlanSegmentRead,
-		Update: resourceNsxtPolicyVlanSegmentUpdate,
-		Delete: resourceNsxtPolicyVlanSegmentDelete,
+		CreateContext: nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyVlanSegmentCreate),
+		ReadContext:   nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyVlanSegmentRead),
+		UpdateContext: nsxtPolicyWithRealizedAlarms(resourceNsxtPolicyVlanSegmentUpdate),
+		Delete:        resourceNsxtPolicyVlanSegmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
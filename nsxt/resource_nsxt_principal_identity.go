@@ -0,0 +1,277 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/trust"
+)
+
+var principalIdentityPermissionGroupValues = []string{"read_only_api_users", "read_write_api_users", "superusers", "undefined"}
+
+const (
+	principalIdentityKeySize       = 2048
+	principalIdentityValidityYears = 10
+)
+
+func resourceNsxtPrincipalIdentity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPrincipalIdentityCreate,
+		Read:   resourceNsxtPrincipalIdentityRead,
+		Delete: resourceNsxtPrincipalIdentityDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"tag": getTagsSchemaForceNew(),
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the principal",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"node_id": {
+				Type:        schema.TypeString,
+				Description: "Unique node-id of the principal",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"permission_group": {
+				Type:         schema.TypeString,
+				Description:  "Permission group of the principal",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(principalIdentityPermissionGroupValues, false),
+			},
+			"is_protected": {
+				Type:        schema.TypeBool,
+				Description: "Indicates whether the entities created by this principal should be protected",
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"certificate_pem": {
+				Type:        schema.TypeString,
+				Description: "PEM encoded certificate of the principal. If not specified, a key pair and a self-signed certificate are generated",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Description: "PEM encoded private key generated for the principal",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"certificate_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the certificate imported for the principal",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func generatePrincipalIdentityCertificate(name string) (string, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, principalIdentityKeySize)
+	if err != nil {
+		return "", "", err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return "", "", err
+	}
+
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             now,
+		NotAfter:              now.AddDate(principalIdentityValidityYears, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+
+	certDer, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return "", "", err
+	}
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDer})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return string(certPem), string(keyPem), nil
+}
+
+func getPrincipalIdentityByID(nsxClient *api.APIClient, id string) (*trust.PrincipalIdentity, error) {
+	identities, _, err := nsxClient.NsxComponentAdministrationApi.GetPrincipalIdentities(nsxClient.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, identity := range identities.Results {
+		if identity.Id == id {
+			return &identity, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceNsxtPrincipalIdentityCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	name := d.Get("name").(string)
+	certPem := d.Get("certificate_pem").(string)
+	if certPem == "" {
+		var keyPem string
+		var err error
+		log.Printf("[INFO] Generating certificate for Principal Identity %s", name)
+		certPem, keyPem, err = generatePrincipalIdentityCertificate(name)
+		if err != nil {
+			return fmt.Errorf("Failed to generate certificate for Principal Identity %s: %v", name, err)
+		}
+		d.Set("certificate_pem", certPem)
+		d.Set("private_key", keyPem)
+	}
+
+	certObj := trust.TrustObjectData{
+		DisplayName: name,
+		PemEncoded:  certPem,
+	}
+	certList, resp, err := nsxClient.NsxComponentAdministrationApi.AddCertificateImport(nsxClient.Context, certObj)
+	if err != nil {
+		return fmt.Errorf("Error during PrincipalIdentity certificate import: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated || len(certList.Results) == 0 {
+		return fmt.Errorf("Unexpected status returned during PrincipalIdentity certificate import: %v", resp.StatusCode)
+	}
+	certID := certList.Results[0].Id
+
+	principalIdentity := trust.PrincipalIdentity{
+		Description:     d.Get("description").(string),
+		Tags:            getTagsFromSchema(d),
+		Name:            name,
+		NodeId:          d.Get("node_id").(string),
+		PermissionGroup: d.Get("permission_group").(string),
+		IsProtected:     d.Get("is_protected").(bool),
+		CertificateId:   certID,
+	}
+
+	log.Printf("[INFO] Registering Principal Identity %s", name)
+	principalIdentity, resp, err = nsxClient.NsxComponentAdministrationApi.RegisterPrincipalIdentity(nsxClient.Context, principalIdentity)
+	if err != nil || resp.StatusCode != http.StatusCreated {
+		// Do not leave the imported certificate behind
+		_, deleteErr := nsxClient.NsxComponentAdministrationApi.DeleteCertificate(nsxClient.Context, certID)
+		if deleteErr != nil {
+			log.Printf("[WARNING] Failed to delete certificate %s: %v", certID, deleteErr)
+		}
+		if err != nil {
+			return fmt.Errorf("Error during PrincipalIdentity create: %v", err)
+		}
+		return fmt.Errorf("Unexpected status returned during PrincipalIdentity create: %v", resp.StatusCode)
+	}
+	d.SetId(principalIdentity.Id)
+
+	return resourceNsxtPrincipalIdentityRead(d, m)
+}
+
+func resourceNsxtPrincipalIdentityRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	principalIdentity, err := getPrincipalIdentityByID(nsxClient, id)
+	if err != nil {
+		return fmt.Errorf("Error during PrincipalIdentity read: %v", err)
+	}
+	if principalIdentity == nil {
+		log.Printf("[DEBUG] PrincipalIdentity %s not found", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", principalIdentity.Description)
+	setTagsInSchema(d, principalIdentity.Tags)
+	d.Set("name", principalIdentity.Name)
+	d.Set("node_id", principalIdentity.NodeId)
+	d.Set("permission_group", principalIdentity.PermissionGroup)
+	d.Set("is_protected", principalIdentity.IsProtected)
+	d.Set("certificate_id", principalIdentity.CertificateId)
+
+	// Certificate PEM is only retrieved when missing from state (on import),
+	// to avoid spurious diffs due to PEM formatting
+	if d.Get("certificate_pem").(string) == "" {
+		cert, _, err := nsxClient.NsxComponentAdministrationApi.GetCertificate(nsxClient.Context, principalIdentity.CertificateId, nil)
+		if err != nil {
+			return fmt.Errorf("Error during PrincipalIdentity certificate read: %v", err)
+		}
+		d.Set("certificate_pem", cert.PemEncoded)
+	}
+
+	return nil
+}
+
+func resourceNsxtPrincipalIdentityDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.NsxComponentAdministrationApi.DeletePrincipalIdentity(nsxClient.Context, id)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return fmt.Errorf("Error during PrincipalIdentity delete: %v", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] PrincipalIdentity %s not found", id)
+	}
+
+	// Certificate is removed even if principal identity is already gone
+	certID := d.Get("certificate_id").(string)
+	if certID != "" {
+		resp, err = nsxClient.NsxComponentAdministrationApi.DeleteCertificate(nsxClient.Context, certID)
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+			return fmt.Errorf("Error during PrincipalIdentity certificate delete: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPrincipalIdentity_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_principal_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXPrincipalIdentityCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXPrincipalIdentityTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXPrincipalIdentityExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "name", name),
+					resource.TestCheckResourceAttr(testResourceName, "node_id", "tf-test-node"),
+					resource.TestCheckResourceAttr(testResourceName, "permission_group", "read_only_api_users"),
+					resource.TestCheckResourceAttr(testResourceName, "is_protected", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "certificate_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "certificate_pem"),
+					resource.TestCheckResourceAttrSet(testResourceName, "private_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPrincipalIdentity_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_principal_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXPrincipalIdentityCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXPrincipalIdentityTemplate(name),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_key"},
+			},
+		},
+	})
+}
+
+func testAccNSXPrincipalIdentityExists(name string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Principal Identity resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Principal Identity resource ID not set in resources ")
+		}
+
+		principalIdentity, err := getPrincipalIdentityByID(nsxClient, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Principal Identity ID %s. Error: %v", resourceID, err)
+		}
+
+		if principalIdentity != nil && name == principalIdentity.Name {
+			return nil
+		}
+		return fmt.Errorf("Principal Identity %s wasn't found", name)
+	}
+}
+
+func testAccNSXPrincipalIdentityCheckDestroy(state *terraform.State, name string) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_principal_identity" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		principalIdentity, err := getPrincipalIdentityByID(nsxClient, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Principal Identity ID %s. Error: %v", resourceID, err)
+		}
+
+		if principalIdentity != nil {
+			return fmt.Errorf("Principal Identity %s still exists", name)
+		}
+	}
+	return nil
+}
+
+func testAccNSXPrincipalIdentityTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_principal_identity" "test" {
+  name             = "%s"
+  node_id          = "tf-test-node"
+  permission_group = "read_only_api_users"
+}`, name)
+}
@@ -0,0 +1,200 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vmware-nsxt/aaa"
+)
+
+var roleBindingTypeValues = []string{"remote_user", "remote_group", "principal_identity"}
+
+func resourceNsxtRoleBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtRoleBindingCreate,
+		Read:   resourceNsxtRoleBindingRead,
+		Update: resourceNsxtRoleBindingUpdate,
+		Delete: resourceNsxtRoleBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"name": {
+				Type:        schema.TypeString,
+				Description: "User, group or principal identity name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "Type of the user this binding applies to",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(roleBindingTypeValues, false),
+			},
+			"roles": {
+				Type:        schema.TypeSet,
+				Description: "Roles assigned to the user",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func getRoleBindingRolesFromSchema(d *schema.ResourceData) []aaa.Role {
+	var roles []aaa.Role
+	for _, role := range getStringListFromSchemaSet(d, "roles") {
+		roles = append(roles, aaa.Role{Role: role})
+	}
+
+	return roles
+}
+
+func setRoleBindingRolesInSchema(d *schema.ResourceData, roles []aaa.Role) error {
+	var roleList []string
+	for _, role := range roles {
+		roleList = append(roleList, role.Role)
+	}
+
+	return d.Set("roles", roleList)
+}
+
+func resourceNsxtRoleBindingCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	roleBinding := aaa.RoleBinding{
+		Description: d.Get("description").(string),
+		DisplayName: d.Get("display_name").(string),
+		Tags:        getTagsFromSchema(d),
+		Name:        d.Get("name").(string),
+		Type_:       d.Get("type").(string),
+		Roles:       getRoleBindingRolesFromSchema(d),
+	}
+
+	log.Printf("[INFO] Creating Role Binding for %s %s", roleBinding.Type_, roleBinding.Name)
+	roleBinding, resp, err := nsxClient.AaaApi.CreateRoleBinding(nsxClient.Context, roleBinding)
+	if err != nil {
+		return fmt.Errorf("Error during RoleBinding create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Unexpected status returned during RoleBinding create: %v", resp.StatusCode)
+	}
+	d.SetId(roleBinding.Id)
+
+	return resourceNsxtRoleBindingRead(d, m)
+}
+
+func resourceNsxtRoleBindingRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	roleBinding, resp, err := nsxClient.AaaApi.GetRoleBinding(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] RoleBinding %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during RoleBinding read: %v", err)
+	}
+
+	d.Set("revision", roleBinding.Revision)
+	d.Set("description", roleBinding.Description)
+	d.Set("display_name", roleBinding.DisplayName)
+	setTagsInSchema(d, roleBinding.Tags)
+	d.Set("name", roleBinding.Name)
+	d.Set("type", roleBinding.Type_)
+	err = setRoleBindingRolesInSchema(d, roleBinding.Roles)
+	if err != nil {
+		return fmt.Errorf("Error during RoleBinding roles set in schema: %v", err)
+	}
+
+	return nil
+}
+
+func resourceNsxtRoleBindingUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	roleBinding := aaa.RoleBinding{
+		Revision:    int64(d.Get("revision").(int)),
+		Description: d.Get("description").(string),
+		DisplayName: d.Get("display_name").(string),
+		Tags:        getTagsFromSchema(d),
+		Name:        d.Get("name").(string),
+		Type_:       d.Get("type").(string),
+		Roles:       getRoleBindingRolesFromSchema(d),
+	}
+
+	log.Printf("[INFO] Updating Role Binding %s", id)
+	_, resp, err := nsxClient.AaaApi.UpdateRoleBinding(nsxClient.Context, id, roleBinding)
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during RoleBinding update: %v", err)
+	}
+
+	return resourceNsxtRoleBindingRead(d, m)
+}
+
+func resourceNsxtRoleBindingDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.AaaApi.DeleteRoleBinding(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during RoleBinding delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] RoleBinding %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
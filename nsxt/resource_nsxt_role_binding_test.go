@@ -0,0 +1,151 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtRoleBinding_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_role_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXRoleBindingCheckDestroy(state, updateName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXRoleBindingCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXRoleBindingExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "name", "tf-test-user@example.com"),
+					resource.TestCheckResourceAttr(testResourceName, "type", "remote_user"),
+					resource.TestCheckResourceAttr(testResourceName, "roles.#", "1"),
+				),
+			},
+			{
+				Config: testAccNSXRoleBindingUpdateTemplate(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXRoleBindingExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "name", "tf-test-user@example.com"),
+					resource.TestCheckResourceAttr(testResourceName, "type", "remote_user"),
+					resource.TestCheckResourceAttr(testResourceName, "roles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtRoleBinding_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_role_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXRoleBindingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXRoleBindingCreateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXRoleBindingExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Role Binding resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Role Binding resource ID not set in resources ")
+		}
+
+		roleBinding, responseCode, err := nsxClient.AaaApi.GetRoleBinding(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Role Binding ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if Role Binding %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == roleBinding.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("Role Binding %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXRoleBindingCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_role_binding" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		roleBinding, responseCode, err := nsxClient.AaaApi.GetRoleBinding(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving Role Binding ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == roleBinding.DisplayName {
+			return fmt.Errorf("Role Binding %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXRoleBindingCreateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_role_binding" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  name         = "tf-test-user@example.com"
+  type         = "remote_user"
+  roles        = ["auditor"]
+}`, name)
+}
+
+func testAccNSXRoleBindingUpdateTemplate(updatedName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_role_binding" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test Update"
+  name         = "tf-test-user@example.com"
+  type         = "remote_user"
+  roles        = ["auditor", "network_op"]
+}`, updatedName)
+}
@@ -0,0 +1,252 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/common"
+)
+
+const (
+	transportNodeCollectionInProgress = "IN_PROGRESS"
+	transportNodeCollectionSuccess    = "SUCCESS"
+)
+
+// Transport node collection API is not part of go-vmware-nsxt, which only
+// has the deprecated compute collection transport node template API
+
+type transportNodeCollection struct {
+	ID                     string       `json:"id,omitempty"`
+	Revision               int64        `json:"_revision"`
+	ResourceType           string       `json:"resource_type"`
+	DisplayName            string       `json:"display_name,omitempty"`
+	Description            string       `json:"description"`
+	Tags                   []common.Tag `json:"tags"`
+	ComputeCollectionID    string       `json:"compute_collection_id"`
+	TransportNodeProfileID string       `json:"transport_node_profile_id"`
+}
+
+type transportNodeCollectionValidationError struct {
+	DiscoveredNodeID string `json:"discovered_node_id,omitempty"`
+	ErrorMessage     string `json:"error_message,omitempty"`
+}
+
+type transportNodeCollectionState struct {
+	State                       string                                   `json:"state"`
+	AggregateProgressPercentage int64                                    `json:"aggregate_progress_percentage,omitempty"`
+	ValidationErrors            []transportNodeCollectionValidationError `json:"validation_errors,omitempty"`
+}
+
+func resourceNsxtTransportNodeCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtTransportNodeCollectionCreate,
+		Read:   resourceNsxtTransportNodeCollectionRead,
+		Update: resourceNsxtTransportNodeCollectionUpdate,
+		Delete: resourceNsxtTransportNodeCollectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"compute_collection_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the compute collection, such as vSphere cluster",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"transport_node_profile_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the transport node profile to apply to the compute collection",
+				Required:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "Host preparation state of the compute collection",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getTransportNodeCollectionFromSchema(d *schema.ResourceData) transportNodeCollection {
+	return transportNodeCollection{
+		Revision:               int64(d.Get("revision").(int)),
+		ResourceType:           "TransportNodeCollection",
+		DisplayName:            d.Get("display_name").(string),
+		Description:            d.Get("description").(string),
+		Tags:                   getTagsFromSchema(d),
+		ComputeCollectionID:    d.Get("compute_collection_id").(string),
+		TransportNodeProfileID: d.Get("transport_node_profile_id").(string),
+	}
+}
+
+func waitForTransportNodeCollectionRealization(id string, m interface{}, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{transportNodeCollectionInProgress},
+		Target:  []string{transportNodeCollectionSuccess},
+		Refresh: func() (interface{}, string, error) {
+			var state transportNodeCollectionState
+			_, err := managerAPIRequest(m, http.MethodGet, "/transport-node-collections/"+id+"/state", nil, &state)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error while querying Transport Node Collection %s state: %v", id, err)
+			}
+
+			log.Printf("[DEBUG] Transport Node Collection %s state %s, progress %d%%", id, state.State, state.AggregateProgressPercentage)
+			if state.State == transportNodeCollectionInProgress || state.State == transportNodeCollectionSuccess {
+				return state, state.State, nil
+			}
+
+			var messages []string
+			for _, e := range state.ValidationErrors {
+				messages = append(messages, fmt.Sprintf("%s: %s", e.DiscoveredNodeID, e.ErrorMessage))
+			}
+			return state, state.State, fmt.Errorf("Host preparation for Transport Node Collection %s is in state %s: %s", id, state.State, strings.Join(messages, "; "))
+		},
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceNsxtTransportNodeCollectionCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	collection := getTransportNodeCollectionFromSchema(d)
+
+	log.Printf("[INFO] Applying Transport Node Profile %s to compute collection %s", collection.TransportNodeProfileID, collection.ComputeCollectionID)
+	var result transportNodeCollection
+	_, err := managerAPIRequest(m, http.MethodPost, "/transport-node-collections", collection, &result)
+	if err != nil {
+		return fmt.Errorf("Error during Transport Node Collection create: %v", err)
+	}
+
+	d.SetId(result.ID)
+	err = waitForTransportNodeCollectionRealization(result.ID, m, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtTransportNodeCollectionRead(d, m)
+}
+
+func resourceNsxtTransportNodeCollectionRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	var collection transportNodeCollection
+	resp, err := managerAPIRequest(m, http.MethodGet, "/transport-node-collections/"+id, nil, &collection)
+	if isManagerAPINotFound(resp) {
+		log.Printf("[DEBUG] Transport Node Collection %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during Transport Node Collection read: %v", err)
+	}
+
+	d.Set("revision", collection.Revision)
+	d.Set("description", collection.Description)
+	d.Set("display_name", collection.DisplayName)
+	setTagsInSchema(d, collection.Tags)
+	d.Set("compute_collection_id", collection.ComputeCollectionID)
+	d.Set("transport_node_profile_id", collection.TransportNodeProfileID)
+
+	var state transportNodeCollectionState
+	_, err = managerAPIRequest(m, http.MethodGet, "/transport-node-collections/"+id+"/state", nil, &state)
+	if err != nil {
+		return fmt.Errorf("Error during Transport Node Collection state read: %v", err)
+	}
+	d.Set("state", state.State)
+
+	return nil
+}
+
+func resourceNsxtTransportNodeCollectionUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	collection := getTransportNodeCollectionFromSchema(d)
+	collection.ID = id
+
+	_, err := managerAPIRequest(m, http.MethodPut, "/transport-node-collections/"+id, collection, nil)
+	if err != nil {
+		return fmt.Errorf("Error during Transport Node Collection update: %v", err)
+	}
+
+	if d.HasChange("transport_node_profile_id") {
+		err = waitForTransportNodeCollectionRealization(id, m, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceNsxtTransportNodeCollectionRead(d, m)
+}
+
+func resourceNsxtTransportNodeCollectionDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	// Detaching the profile does not remove NSX from the hosts
+	resp, err := managerAPIRequest(m, http.MethodDelete, "/transport-node-collections/"+id, nil, nil)
+	if isManagerAPINotFound(resp) {
+		log.Printf("[DEBUG] Transport Node Collection %s not found", id)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during Transport Node Collection delete: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtTransportNodeCollection_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_transport_node_collection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNsxtTransportNodeProfilePreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_COMPUTE_COLLECTION_ID")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtTransportNodeCollectionCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtTransportNodeCollectionTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "compute_collection_id", os.Getenv("NSXT_TEST_COMPUTE_COLLECTION_ID")),
+					resource.TestCheckResourceAttrPair(testResourceName, "transport_node_profile_id", "nsxt_transport_node_profile.test", "id"),
+					resource.TestCheckResourceAttr(testResourceName, "state", "SUCCESS"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtTransportNodeCollectionCheckDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_transport_node_collection" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		resp, err := managerAPIRequest(testAccProvider.Meta(), http.MethodGet, "/transport-node-collections/"+resourceID, nil, nil)
+		if isManagerAPINotFound(resp) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Transport Node Collection %s: %v", resourceID, err)
+		}
+
+		return fmt.Errorf("Transport Node Collection %s still exists", resourceID)
+	}
+	return nil
+}
+
+func testAccNsxtTransportNodeCollectionTemplate(name string) string {
+	return testAccNsxtTransportNodeProfileTemplate(name, "vmnic1") + fmt.Sprintf(`
+
+resource "nsxt_transport_node_collection" "test" {
+  display_name              = "%s"
+  compute_collection_id     = "%s"
+  transport_node_profile_id = nsxt_transport_node_profile.test.id
+}`, name, os.Getenv("NSXT_TEST_COMPUTE_COLLECTION_ID"))
+}
@@ -0,0 +1,163 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/common"
+)
+
+type transportNodeProfile struct {
+	ID             string                       `json:"id,omitempty"`
+	Revision       int64                        `json:"_revision"`
+	ResourceType   string                       `json:"resource_type"`
+	DisplayName    string                       `json:"display_name,omitempty"`
+	Description    string                       `json:"description"`
+	Tags           []common.Tag                 `json:"tags"`
+	HostSwitchSpec *transportNodeHostSwitchSpec `json:"host_switch_spec,omitempty"`
+}
+
+func resourceNsxtTransportNodeProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtTransportNodeProfileCreate,
+		Read:   resourceNsxtTransportNodeProfileRead,
+		Update: resourceNsxtTransportNodeProfileUpdate,
+		Delete: resourceNsxtTransportNodeProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag":         getTagsSchema(),
+			"host_switch": getTransportNodeHostSwitchSchema(true),
+		},
+	}
+}
+
+func getTransportNodeProfileFromSchema(d *schema.ResourceData) transportNodeProfile {
+	hostSwitchSpec := getTransportNodeHostSwitchSpecFromSchema(d, true)
+	return transportNodeProfile{
+		Revision:       int64(d.Get("revision").(int)),
+		ResourceType:   "TransportNodeProfile",
+		DisplayName:    d.Get("display_name").(string),
+		Description:    d.Get("description").(string),
+		Tags:           getTagsFromSchema(d),
+		HostSwitchSpec: &hostSwitchSpec,
+	}
+}
+
+func resourceNsxtTransportNodeProfileCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	profile := getTransportNodeProfileFromSchema(d)
+
+	log.Printf("[INFO] Creating Transport Node Profile %s", profile.DisplayName)
+	var result transportNodeProfile
+	_, err := managerAPIRequest(m, http.MethodPost, "/transport-node-profiles", profile, &result)
+	if err != nil {
+		return fmt.Errorf("Error during Transport Node Profile create: %v", err)
+	}
+
+	d.SetId(result.ID)
+
+	return resourceNsxtTransportNodeProfileRead(d, m)
+}
+
+func resourceNsxtTransportNodeProfileRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	var profile transportNodeProfile
+	resp, err := managerAPIRequest(m, http.MethodGet, "/transport-node-profiles/"+id, nil, &profile)
+	if isManagerAPINotFound(resp) {
+		log.Printf("[DEBUG] Transport Node Profile %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during Transport Node Profile read: %v", err)
+	}
+
+	d.Set("revision", profile.Revision)
+	d.Set("description", profile.Description)
+	d.Set("display_name", profile.DisplayName)
+	setTagsInSchema(d, profile.Tags)
+	err = setTransportNodeHostSwitchSpecInSchema(d, profile.HostSwitchSpec, true)
+	if err != nil {
+		return fmt.Errorf("Error during Transport Node Profile host switches set in schema: %v", err)
+	}
+
+	return nil
+}
+
+func resourceNsxtTransportNodeProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	profile := getTransportNodeProfileFromSchema(d)
+	profile.ID = id
+
+	_, err := managerAPIRequest(m, http.MethodPut, "/transport-node-profiles/"+id, profile, nil)
+	if err != nil {
+		return fmt.Errorf("Error during Transport Node Profile update: %v", err)
+	}
+
+	return resourceNsxtTransportNodeProfileRead(d, m)
+}
+
+func resourceNsxtTransportNodeProfileDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := managerAPIRequest(m, http.MethodDelete, "/transport-node-profiles/"+id, nil, nil)
+	if isManagerAPINotFound(resp) {
+		log.Printf("[DEBUG] Transport Node Profile %s not found", id)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during Transport Node Profile delete: %v", err)
+	}
+
+	return nil
+}
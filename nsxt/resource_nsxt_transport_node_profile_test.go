@@ -0,0 +1,151 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccNsxtTransportNodeProfilePreCheck(t *testing.T) {
+	testAccOnlyLocalManager(t)
+	testAccTestMP(t)
+	testAccPreCheck(t)
+	testAccEnvDefined(t, "NSXT_TEST_HOST_UPLINK_PROFILE_ID")
+}
+
+func TestAccResourceNsxtTransportNodeProfile_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_transport_node_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccNsxtTransportNodeProfilePreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtTransportNodeProfileCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtTransportNodeProfileTemplate(name, "vmnic1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtTransportNodeProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch.0.host_switch_type", "NVDS"),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch.0.host_switch_mode", "STANDARD"),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch.0.pnic.0.device_name", "vmnic1"),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch.0.transport_zone_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "host_switch.0.lldp_profile_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtTransportNodeProfileTemplate(updateName, "vmnic2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtTransportNodeProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch.0.pnic.0.device_name", "vmnic2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtTransportNodeProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_transport_node_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccNsxtTransportNodeProfilePreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtTransportNodeProfileCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtTransportNodeProfileTemplate(name, "vmnic1"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtTransportNodeProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Transport Node Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Transport Node Profile resource ID not set in resources")
+		}
+
+		_, err := managerAPIRequest(testAccProvider.Meta(), http.MethodGet, "/transport-node-profiles/"+resourceID, nil, nil)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Transport Node Profile %s: %v", resourceID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtTransportNodeProfileCheckDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_transport_node_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		resp, err := managerAPIRequest(testAccProvider.Meta(), http.MethodGet, "/transport-node-profiles/"+resourceID, nil, nil)
+		if isManagerAPINotFound(resp) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Transport Node Profile %s: %v", resourceID, err)
+		}
+
+		return fmt.Errorf("Transport Node Profile %s still exists", resourceID)
+	}
+	return nil
+}
+
+func testAccNsxtTransportNodeProfileTemplate(name string, deviceName string) string {
+	return fmt.Sprintf(`
+data "nsxt_transport_zone" "overlay" {
+  display_name = "%s"
+}
+
+resource "nsxt_transport_node_profile" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  host_switch {
+    host_switch_name   = "tf-test-nvds"
+    uplink_profile_id  = "%s"
+    transport_zone_ids = [data.nsxt_transport_zone.overlay.id]
+
+    pnic {
+      device_name = "%s"
+      uplink_name = "uplink-1"
+    }
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, getOverlayTransportZoneName(), name, os.Getenv("NSXT_TEST_HOST_UPLINK_PROFILE_ID"), deviceName)
+}
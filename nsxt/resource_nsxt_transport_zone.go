@@ -0,0 +1,214 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+var transportZoneTransportTypeValues = []string{"OVERLAY", "VLAN"}
+
+// BFD health monitoring is the only transport zone profile type
+const transportZoneProfileResourceType = "BfdHealthMonitoringProfile"
+
+func resourceNsxtTransportZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtTransportZoneCreate,
+		Read:   resourceNsxtTransportZoneRead,
+		Update: resourceNsxtTransportZoneUpdate,
+		Delete: resourceNsxtTransportZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"host_switch_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the host switch on all transport nodes in this transport zone that will be used to run NSX network traffic",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"transport_type": {
+				Type:         schema.TypeString,
+				Description:  "The transport type of this transport zone",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(transportZoneTransportTypeValues, false),
+			},
+			"nested_nsx": {
+				Type:        schema.TypeBool,
+				Description: "Flag to indicate that NSX is installed in nested environment in this transport zone",
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"transport_zone_profile_ids": {
+				Type:        schema.TypeSet,
+				Description: "IDs of BFD health monitoring profiles applied to this transport zone",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func getTransportZoneProfileIdsFromSchema(d *schema.ResourceData) []manager.TransportZoneProfileTypeIdEntry {
+	var profiles []manager.TransportZoneProfileTypeIdEntry
+	for _, profileID := range getStringListFromSchemaSet(d, "transport_zone_profile_ids") {
+		profiles = append(profiles, manager.TransportZoneProfileTypeIdEntry{
+			ProfileId:    profileID,
+			ResourceType: transportZoneProfileResourceType,
+		})
+	}
+
+	return profiles
+}
+
+func setTransportZoneProfileIdsInSchema(d *schema.ResourceData, profiles []manager.TransportZoneProfileTypeIdEntry) error {
+	var profileIDs []string
+	for _, profile := range profiles {
+		profileIDs = append(profileIDs, profile.ProfileId)
+	}
+
+	return d.Set("transport_zone_profile_ids", profileIDs)
+}
+
+func getTransportZoneFromSchema(d *schema.ResourceData) manager.TransportZone {
+	return manager.TransportZone{
+		Description:             d.Get("description").(string),
+		DisplayName:             d.Get("display_name").(string),
+		Tags:                    getTagsFromSchema(d),
+		HostSwitchName:          d.Get("host_switch_name").(string),
+		TransportType:           d.Get("transport_type").(string),
+		NestedNsx:               d.Get("nested_nsx").(bool),
+		TransportZoneProfileIds: getTransportZoneProfileIdsFromSchema(d),
+	}
+}
+
+func resourceNsxtTransportZoneCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	transportZone := getTransportZoneFromSchema(d)
+
+	log.Printf("[INFO] Creating Transport Zone %s", transportZone.DisplayName)
+	transportZone, resp, err := nsxClient.NetworkTransportApi.CreateTransportZone(nsxClient.Context, transportZone)
+	if err != nil {
+		return fmt.Errorf("Error during TransportZone create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("Unexpected status returned during TransportZone create: %v", resp.StatusCode)
+	}
+	d.SetId(transportZone.Id)
+
+	return resourceNsxtTransportZoneRead(d, m)
+}
+
+func resourceNsxtTransportZoneRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	transportZone, resp, err := nsxClient.NetworkTransportApi.GetTransportZone(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] TransportZone %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error during TransportZone read: %v", err)
+	}
+
+	d.Set("revision", transportZone.Revision)
+	d.Set("description", transportZone.Description)
+	d.Set("display_name", transportZone.DisplayName)
+	setTagsInSchema(d, transportZone.Tags)
+	d.Set("host_switch_name", transportZone.HostSwitchName)
+	d.Set("transport_type", transportZone.TransportType)
+	d.Set("nested_nsx", transportZone.NestedNsx)
+	err = setTransportZoneProfileIdsInSchema(d, transportZone.TransportZoneProfileIds)
+	if err != nil {
+		return fmt.Errorf("Error during TransportZone profiles set in schema: %v", err)
+	}
+
+	return nil
+}
+
+func resourceNsxtTransportZoneUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	transportZone := getTransportZoneFromSchema(d)
+	transportZone.Revision = int64(d.Get("revision").(int))
+
+	log.Printf("[INFO] Updating Transport Zone %s", id)
+	_, resp, err := nsxClient.NetworkTransportApi.UpdateTransportZone(nsxClient.Context, id, transportZone)
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("Error during TransportZone update: %v", err)
+	}
+
+	return resourceNsxtTransportZoneRead(d, m)
+}
+
+func resourceNsxtTransportZoneDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining logical object id")
+	}
+
+	resp, err := nsxClient.NetworkTransportApi.DeleteTransportZone(nsxClient.Context, id)
+	if err != nil {
+		return fmt.Errorf("Error during TransportZone delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] TransportZone %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtTransportZone_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_transport_zone.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXTransportZoneCheckDestroy(state, updateName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXTransportZoneCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXTransportZoneExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_type", "VLAN"),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch_name", "tf-test-switch"),
+					resource.TestCheckResourceAttr(testResourceName, "nested_nsx", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNSXTransportZoneUpdateTemplate(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNSXTransportZoneExists(updateName, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_type", "VLAN"),
+					resource.TestCheckResourceAttr(testResourceName, "host_switch_name", "tf-test-switch"),
+					resource.TestCheckResourceAttr(testResourceName, "nested_nsx", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtTransportZone_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_transport_zone.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNSXTransportZoneCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXTransportZoneCreateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNSXTransportZoneExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Transport Zone resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Transport Zone resource ID not set in resources ")
+		}
+
+		transportZone, responseCode, err := nsxClient.NetworkTransportApi.GetTransportZone(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Transport Zone ID %s. Error: %v", resourceID, err)
+		}
+
+		if responseCode.StatusCode != http.StatusOK {
+			return fmt.Errorf("Error while checking if Transport Zone %s exists. HTTP return code was %d", resourceID, responseCode.StatusCode)
+		}
+
+		if displayName == transportZone.DisplayName {
+			return nil
+		}
+		return fmt.Errorf("Transport Zone %s wasn't found", displayName)
+	}
+}
+
+func testAccNSXTransportZoneCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_transport_zone" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		transportZone, responseCode, err := nsxClient.NetworkTransportApi.GetTransportZone(nsxClient.Context, resourceID)
+		if err != nil {
+			if responseCode.StatusCode != http.StatusOK {
+				return nil
+			}
+			return fmt.Errorf("Error while retrieving Transport Zone ID %s. Error: %v", resourceID, err)
+		}
+
+		if displayName == transportZone.DisplayName {
+			return fmt.Errorf("Transport Zone %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNSXTransportZoneCreateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_transport_zone" "test" {
+  display_name     = "%s"
+  description      = "Acceptance Test"
+  host_switch_name = "tf-test-switch"
+  transport_type   = "VLAN"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func testAccNSXTransportZoneUpdateTemplate(updatedName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_transport_zone" "test" {
+  display_name     = "%s"
+  description      = "Acceptance Test Update"
+  host_switch_name = "tf-test-switch"
+  transport_type   = "VLAN"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  tag {
+    scope = "scope2"
+    tag   = "tag2"
+  }
+}`, updatedName)
+}
@@ -0,0 +1,612 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/upgrade"
+)
+
+const (
+	upgradeComponentEdge = "EDGE"
+	upgradeComponentHost = "HOST"
+	upgradeComponentMP   = "MP"
+)
+
+const (
+	upgradeStatusNotStarted = "NOT_STARTED"
+	upgradeStatusInProgress = "IN_PROGRESS"
+	upgradeStatusPausing    = "PAUSING"
+	upgradeStatusPaused     = "PAUSED"
+	upgradeStatusSuccess    = "SUCCESS"
+	upgradeStatusFailed     = "FAILED"
+)
+
+const (
+	upgradeBundleStatusSuccess  = "SUCCESS"
+	upgradeBundleStatusFailed   = "FAILED"
+	upgradeUCStatusSuccess      = "SUCCESS"
+	upgradeUCStatusFailed       = "FAILED"
+	upgradePreCheckInProgress   = "IN_PROGRESS"
+	upgradePreCheckStateRunning = "running"
+	upgradePreCheckStateDone    = "done"
+)
+
+// Bundle, upgrade coordinator and pre-check APIs are not part of the
+// go-vmware-nsxt upgrade client, hence the types below
+
+type upgradeBundleFetchRequest struct {
+	URL string `json:"url"`
+}
+
+type upgradeBundleID struct {
+	BundleID string `json:"bundle_id"`
+}
+
+type upgradeBundleUploadStatus struct {
+	Status         string `json:"status"`
+	DetailedStatus string `json:"detailed_status,omitempty"`
+}
+
+type upgradeUCUpgradeStatus struct {
+	State   string `json:"state"`
+	Details string `json:"details,omitempty"`
+}
+
+type upgradePreCheckStatus struct {
+	Status string `json:"status"`
+}
+
+type upgradeComponentPreCheckStatus struct {
+	ComponentType    string                 `json:"component_type"`
+	PreUpgradeStatus *upgradePreCheckStatus `json:"pre_upgrade_status,omitempty"`
+}
+
+type upgradePreCheckStatusSummary struct {
+	ComponentStatus []upgradeComponentPreCheckStatus `json:"component_status"`
+}
+
+type upgradeCheckFailureMessage struct {
+	Message string `json:"message"`
+}
+
+type upgradeCheckFailure struct {
+	Type       string                     `json:"type"`
+	Message    upgradeCheckFailureMessage `json:"message"`
+	OriginID   string                     `json:"origin_id"`
+	OriginType string                     `json:"origin_type"`
+}
+
+type upgradeCheckFailureListResult struct {
+	Results []upgradeCheckFailure `json:"results"`
+}
+
+var upgradePlanComponents = map[string]string{
+	"edge_plan": upgradeComponentEdge,
+	"host_plan": upgradeComponentHost,
+	"mp_plan":   upgradeComponentMP,
+}
+
+func resourceNsxtUpgradePlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtUpgradePlanCreate,
+		Read:   resourceNsxtUpgradePlanRead,
+		Update: resourceNsxtUpgradePlanUpdate,
+		Delete: resourceNsxtUpgradePlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Hour),
+			Update: schema.DefaultTimeout(4 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"edge_plan": getUpgradeComponentPlanSchema("Edge"),
+			"host_plan": getUpgradeComponentPlanSchema("Host"),
+			"mp_plan":   getUpgradeComponentPlanSchema("Management plane"),
+			"bundle_url": {
+				Type:        schema.TypeString,
+				Description: "URL of upgrade bundle for NSX to fetch. Upgrade coordinator is upgraded once the bundle is fetched",
+				Optional:    true,
+			},
+			"bundle_id": {
+				Type:        schema.TypeString,
+				Description: "ID of fetched upgrade bundle",
+				Computed:    true,
+			},
+			"run_pre_checks": {
+				Type:        schema.TypeBool,
+				Description: "Run pre-upgrade checks before the upgrade",
+				Optional:    true,
+				Default:     false,
+			},
+			"pre_check_failure": {
+				Type:        schema.TypeList,
+				Description: "Failures and warnings reported by pre-upgrade checks",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Type of the failure, FAILURE or WARNING",
+							Computed:    true,
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Description: "Failure message",
+							Computed:    true,
+						},
+						"origin_type": {
+							Type:        schema.TypeString,
+							Description: "Type of the object the failure refers to",
+							Computed:    true,
+						},
+						"origin_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the object the failure refers to",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"run_upgrade": {
+				Type:        schema.TypeBool,
+				Description: "Start or continue the upgrade and wait for it to complete or pause",
+				Optional:    true,
+				Default:     false,
+			},
+			"continue_upgrade": {
+				Type:        schema.TypeInt,
+				Description: "Change this value to continue paused or failed upgrade",
+				Optional:    true,
+			},
+			"upgrade_status": {
+				Type:        schema.TypeString,
+				Description: "Overall upgrade status",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getUpgradeComponentPlanSchema(component string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: fmt.Sprintf("%s upgrade plan settings", component),
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"parallel": {
+					Type:        schema.TypeBool,
+					Description: "Upgrade upgrade unit groups in parallel. If not set, the value currently configured on NSX is kept",
+					Optional:    true,
+					Computed:    true,
+				},
+				"pause_after_each_group": {
+					Type:        schema.TypeBool,
+					Description: "Pause upgrade after each upgrade unit group",
+					Optional:    true,
+					Default:     false,
+				},
+				"pause_on_error": {
+					Type:        schema.TypeBool,
+					Description: "Pause upgrade on first error",
+					Optional:    true,
+					Default:     false,
+				},
+				"group_order": {
+					Type:        schema.TypeList,
+					Description: "Upgrade unit group IDs in the order they should be upgraded",
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func getUpgradeUnitGroupIDs(nsxClient *api.APIClient, componentType string) ([]string, error) {
+	localVarOptionals := make(map[string]interface{})
+	localVarOptionals["componentType"] = componentType
+	groups, _, err := nsxClient.UpgradeApi.GetUpgradeUnitGroups(nsxClient.Context, localVarOptionals)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, group := range groups.Results {
+		ids = append(ids, group.Id)
+	}
+	return ids, nil
+}
+
+func updateUpgradeComponentPlan(d *schema.ResourceData, m interface{}, schemaName string, componentType string) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	plans := d.Get(schemaName).([]interface{})
+	if len(plans) == 0 || plans[0] == nil {
+		return nil
+	}
+	plan := plans[0].(map[string]interface{})
+
+	// If parallel is not configured, the value currently set on NSX is kept.
+	// Settings are sent as a map, since go-vmware-nsxt model omits false values
+	current, _, err := nsxClient.UpgradeApi.GetUpgradePlanSettings(nsxClient.Context, componentType)
+	if err != nil {
+		return fmt.Errorf("Failed to read %s upgrade plan settings: %v", componentType, err)
+	}
+	parallel := current.Parallel
+	if value, ok := d.GetOkExists(schemaName + ".0.parallel"); ok {
+		parallel = value.(bool)
+	}
+	settings := map[string]interface{}{
+		"parallel":               parallel,
+		"pause_after_each_group": plan["pause_after_each_group"].(bool),
+		"pause_on_error":         plan["pause_on_error"].(bool),
+	}
+	_, err = managerAPIRequest(m, http.MethodPut, "/upgrade/plan/"+componentType+"/settings", settings, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to update %s upgrade plan settings: %v", componentType, err)
+	}
+
+	// Place each group right after the previous one in the list
+	groupOrder := interface2StringList(plan["group_order"].([]interface{}))
+	for i := 1; i < len(groupOrder); i++ {
+		reorderRequest := upgrade.ReorderRequest{
+			Id:       groupOrder[i-1],
+			IsBefore: false,
+		}
+		_, err = nsxClient.UpgradeApi.ReorderUpgradeUnitGroupReorder(nsxClient.Context, groupOrder[i], reorderRequest)
+		if err != nil {
+			return fmt.Errorf("Failed to reorder %s upgrade unit group %s: %v", componentType, groupOrder[i], err)
+		}
+	}
+
+	return nil
+}
+
+// Fetches upgrade bundle from URL, and upgrades upgrade coordinator with it
+func fetchUpgradeBundle(d *schema.ResourceData, m interface{}, timeout time.Duration) error {
+	bundleURL := d.Get("bundle_url").(string)
+	log.Printf("[INFO] Fetching upgrade bundle from %s", bundleURL)
+	var bundle upgradeBundleID
+	_, err := managerAPIRequest(m, http.MethodPost, "/upgrade/bundles", upgradeBundleFetchRequest{URL: bundleURL}, &bundle)
+	if err != nil {
+		return fmt.Errorf("Failed to fetch upgrade bundle: %v", err)
+	}
+	d.Set("bundle_id", bundle.BundleID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{upgradeBundleStatusSuccess},
+		Refresh: func() (interface{}, string, error) {
+			var status upgradeBundleUploadStatus
+			_, err := managerAPIRequest(m, http.MethodGet, "/upgrade/bundles/"+bundle.BundleID+"/upload-status", nil, &status)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error while querying upgrade bundle status: %v", err)
+			}
+
+			log.Printf("[DEBUG] Upgrade bundle status: %s", status.Status)
+			if status.Status == upgradeBundleStatusFailed {
+				return status, status.Status, fmt.Errorf("Upgrade bundle fetch failed: %s", status.DetailedStatus)
+			}
+			if status.Status == upgradeBundleStatusSuccess {
+				return status, status.Status, nil
+			}
+			return status, "pending", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Upgrading upgrade coordinator")
+	_, err = managerAPIRequest(m, http.MethodPost, "/upgrade?action=upgrade_uc", nil, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to upgrade upgrade coordinator: %v", err)
+	}
+
+	stateConf = &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{upgradeUCStatusSuccess},
+		Refresh: func() (interface{}, string, error) {
+			var status upgradeUCUpgradeStatus
+			_, err := managerAPIRequest(m, http.MethodGet, "/upgrade/uc-upgrade-status", nil, &status)
+			if err != nil {
+				// Upgrade coordinator is restarted during its upgrade
+				log.Printf("[DEBUG] Upgrade coordinator status is not available: %v", err)
+				return status, "pending", nil
+			}
+
+			log.Printf("[DEBUG] Upgrade coordinator upgrade state: %s", status.State)
+			if status.State == upgradeUCStatusFailed {
+				return status, status.State, fmt.Errorf("Upgrade coordinator upgrade failed: %s", status.Details)
+			}
+			if status.State == upgradeUCStatusSuccess {
+				return status, status.State, nil
+			}
+			return status, "pending", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+// Runs pre-upgrade checks and waits for them to complete. Results are
+// exposed in pre_check_failure, and do not fail the apply.
+func runUpgradePreChecks(m interface{}, timeout time.Duration) error {
+	log.Printf("[INFO] Running pre-upgrade checks")
+	_, err := managerAPIRequest(m, http.MethodPost, "/upgrade?action=execute_pre_upgrade_checks", nil, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to run pre-upgrade checks: %v", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{upgradePreCheckStateRunning},
+		Target:  []string{upgradePreCheckStateDone},
+		Refresh: func() (interface{}, string, error) {
+			var summary upgradePreCheckStatusSummary
+			_, err := managerAPIRequest(m, http.MethodGet, "/upgrade/status-summary", nil, &summary)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error while querying pre-upgrade checks status: %v", err)
+			}
+
+			for _, component := range summary.ComponentStatus {
+				if component.PreUpgradeStatus != nil && component.PreUpgradeStatus.Status == upgradePreCheckInProgress {
+					log.Printf("[DEBUG] Pre-upgrade checks for %s are in progress", component.ComponentType)
+					return summary, upgradePreCheckStateRunning, nil
+				}
+			}
+			return summary, upgradePreCheckStateDone, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func setUpgradePreCheckFailuresInSchema(d *schema.ResourceData, m interface{}) error {
+	var failures upgradeCheckFailureListResult
+	_, err := managerAPIRequest(m, http.MethodGet, "/upgrade/pre-upgrade-checks/failures", nil, &failures)
+	if err != nil {
+		return err
+	}
+
+	var failureList []map[string]interface{}
+	for _, failure := range failures.Results {
+		failureList = append(failureList, map[string]interface{}{
+			"type":        failure.Type,
+			"message":     failure.Message.Message,
+			"origin_type": failure.OriginType,
+			"origin_id":   failure.OriginID,
+		})
+	}
+	return d.Set("pre_check_failure", failureList)
+}
+
+func getUpgradeStatus(nsxClient *api.APIClient) (string, error) {
+	status, _, err := nsxClient.UpgradeApi.GetUpgradeStatusSummary(nsxClient.Context, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return status.OverallUpgradeStatus, nil
+}
+
+// Starts the upgrade, or continues it if allowed, and waits for it to complete or pause
+func runUpgrade(nsxClient *api.APIClient, timeout time.Duration, allowContinue bool) error {
+	status, err := getUpgradeStatus(nsxClient)
+	if err != nil {
+		return fmt.Errorf("Failed to read upgrade status: %v", err)
+	}
+
+	switch status {
+	case upgradeStatusSuccess:
+		log.Printf("[INFO] Upgrade already completed")
+		return nil
+	case upgradeStatusNotStarted:
+		log.Printf("[INFO] Starting upgrade")
+		_, err = nsxClient.UpgradeApi.StartUpgradeStart(nsxClient.Context)
+		if err != nil {
+			return fmt.Errorf("Failed to start upgrade: %v", err)
+		}
+	case upgradeStatusPaused, upgradeStatusFailed:
+		if !allowContinue {
+			log.Printf("[INFO] Upgrade is not continued with status %s", status)
+			return nil
+		}
+		log.Printf("[INFO] Continuing upgrade with status %s", status)
+		_, err = nsxClient.UpgradeApi.ContinueUpgradeContinue(nsxClient.Context, nil)
+		if err != nil {
+			return fmt.Errorf("Failed to continue upgrade with status %s: %v", status, err)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{upgradeStatusNotStarted, upgradeStatusInProgress, upgradeStatusPausing},
+		Target:  []string{upgradeStatusSuccess, upgradeStatusPaused},
+		Refresh: func() (interface{}, string, error) {
+			status, err := getUpgradeStatus(nsxClient)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error while querying upgrade status: %v", err)
+			}
+
+			if status == upgradeStatusFailed {
+				return nil, "", fmt.Errorf("Upgrade failed, please check upgrade status in NSX")
+			}
+
+			log.Printf("[DEBUG] Upgrade status: %s", status)
+			return status, status, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      30 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func upgradePlanUpdate(d *schema.ResourceData, m interface{}, timeout time.Duration) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+
+	// Bundle is fetched before the plan is configured, since upgrade
+	// coordinator upgrade resets the plan
+	if d.Get("bundle_url").(string) != "" && (d.IsNewResource() || d.HasChange("bundle_url")) {
+		if err := fetchUpgradeBundle(d, m, timeout); err != nil {
+			return err
+		}
+	}
+
+	for schemaName, componentType := range upgradePlanComponents {
+		if err := updateUpgradeComponentPlan(d, m, schemaName, componentType); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("run_pre_checks").(bool) && (d.IsNewResource() || d.HasChange("run_pre_checks") || d.HasChange("bundle_url")) {
+		if err := runUpgradePreChecks(m, timeout); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("run_upgrade").(bool) {
+		// Paused or failed upgrade is only continued on explicit request, so
+		// that changing plan settings does not resume the upgrade
+		allowContinue := d.IsNewResource() || d.HasChange("run_upgrade") || d.HasChange("continue_upgrade")
+		return runUpgrade(nsxClient, timeout, allowContinue)
+	}
+
+	return nil
+}
+
+func resourceNsxtUpgradePlanCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	log.Printf("[INFO] Configuring upgrade plan")
+	// ID is kept on failure, since the upgrade might have been started
+	d.SetId(newUUID())
+	err := upgradePlanUpdate(d, m, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error during upgrade plan create: %v", err)
+	}
+
+	return resourceNsxtUpgradePlanRead(d, m)
+}
+
+func resourceNsxtUpgradePlanRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	for schemaName, componentType := range upgradePlanComponents {
+		settings, _, err := nsxClient.UpgradeApi.GetUpgradePlanSettings(nsxClient.Context, componentType)
+		if err != nil {
+			return fmt.Errorf("Error during %s upgrade plan read: %v", componentType, err)
+		}
+
+		elem := make(map[string]interface{})
+		elem["parallel"] = settings.Parallel
+		elem["pause_after_each_group"] = settings.PauseAfterEachGroup
+		elem["pause_on_error"] = settings.PauseOnError
+
+		// Only groups referenced in configuration are reported, in
+		// the order they are upgraded by NSX
+		var configuredGroups []string
+		if plans := d.Get(schemaName).([]interface{}); len(plans) > 0 && plans[0] != nil {
+			configuredGroups = interface2StringList(plans[0].(map[string]interface{})["group_order"].([]interface{}))
+		}
+		groupIDs, err := getUpgradeUnitGroupIDs(nsxClient, componentType)
+		if err != nil {
+			return fmt.Errorf("Error during %s upgrade unit groups read: %v", componentType, err)
+		}
+		var groupOrder []string
+		for _, id := range groupIDs {
+			if stringInList(id, configuredGroups) {
+				groupOrder = append(groupOrder, id)
+			}
+		}
+		elem["group_order"] = groupOrder
+
+		d.Set(schemaName, []interface{}{elem})
+	}
+
+	status, err := getUpgradeStatus(nsxClient)
+	if err != nil {
+		return fmt.Errorf("Error during upgrade status read: %v", err)
+	}
+	d.Set("upgrade_status", status)
+
+	err = setUpgradePreCheckFailuresInSchema(d, m)
+	if err != nil {
+		return fmt.Errorf("Error during pre-upgrade check failures read: %v", err)
+	}
+
+	return nil
+}
+
+func resourceNsxtUpgradePlanUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	log.Printf("[INFO] Updating upgrade plan")
+	err := upgradePlanUpdate(d, m, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error during upgrade plan update: %v", err)
+	}
+
+	return resourceNsxtUpgradePlanRead(d, m)
+}
+
+func resourceNsxtUpgradePlanDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	status, err := getUpgradeStatus(nsxClient)
+	if err != nil {
+		return fmt.Errorf("Error during upgrade status read: %v", err)
+	}
+
+	// Upgrade plan can only be reset before upgrade is started
+	if status != upgradeStatusNotStarted {
+		log.Printf("[INFO] Upgrade plan is not reset since upgrade status is %s", status)
+		return nil
+	}
+
+	for _, componentType := range upgradePlanComponents {
+		_, err := nsxClient.UpgradeApi.ResetUpgradePlanReset(nsxClient.Context, componentType)
+		if err != nil {
+			return fmt.Errorf("Error during %s upgrade plan reset: %v", componentType, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtUpgradePlan_basic(t *testing.T) {
+	testResourceName := "nsxt_upgrade_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccTestMP(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_UPGRADE")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtUpgradePlanTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "edge_plan.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "edge_plan.0.pause_after_each_group", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "edge_plan.0.pause_on_error", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "edge_plan.0.parallel", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "host_plan.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "host_plan.0.parallel"),
+					resource.TestCheckResourceAttr(testResourceName, "host_plan.0.pause_on_error", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "mp_plan.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "run_upgrade", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "upgrade_status"),
+				),
+			},
+			{
+				Config: testAccNsxtUpgradePlanTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "edge_plan.0.pause_after_each_group", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "edge_plan.0.pause_on_error", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "edge_plan.0.parallel", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "host_plan.0.pause_on_error", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtUpgradePlan_preChecks(t *testing.T) {
+	testResourceName := "nsxt_upgrade_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccTestMP(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_UPGRADE")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "nsxt_upgrade_plan" "test" {
+  run_pre_checks = true
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "run_pre_checks", "true"),
+					resource.TestCheckResourceAttrSet(testResourceName, "pre_check_failure.#"),
+					resource.TestCheckResourceAttr(testResourceName, "upgrade_status", upgradeStatusNotStarted),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtUpgradePlanTemplate(pause bool) string {
+	return fmt.Sprintf(`
+resource "nsxt_upgrade_plan" "test" {
+  edge_plan {
+    parallel               = %t
+    pause_after_each_group = %t
+    pause_on_error         = %t
+  }
+
+  host_plan {
+    pause_on_error = %t
+  }
+}`, !pause, pause, pause, pause)
+}
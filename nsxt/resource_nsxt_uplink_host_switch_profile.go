@@ -0,0 +1,266 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+const (
+	uplinkHostSwitchProfileName = "Uplink Host Switch Profile"
+	uplinkHostSwitchPnicType    = "PNIC"
+)
+
+var uplinkHostSwitchTeamingPolicyValues = []string{"FAILOVER_ORDER", "LOADBALANCE_SRCID", "LOADBALANCE_SRC_MAC"}
+
+type uplinkHostSwitchNamedTeaming struct {
+	Name        string           `json:"name"`
+	Policy      string           `json:"policy"`
+	ActiveList  []manager.Uplink `json:"active_list"`
+	StandbyList []manager.Uplink `json:"standby_list,omitempty"`
+}
+
+// go-vmware-nsxt UplinkHostSwitchProfile has no named teamings, and
+// omits transport VLAN 0
+type uplinkHostSwitchProfile struct {
+	Revision      int64                          `json:"_revision"`
+	ResourceType  string                         `json:"resource_type"`
+	DisplayName   string                         `json:"display_name,omitempty"`
+	Description   string                         `json:"description"`
+	Tags          []common.Tag                   `json:"tags"`
+	Teaming       *manager.TeamingPolicy         `json:"teaming"`
+	NamedTeamings []uplinkHostSwitchNamedTeaming `json:"named_teamings"`
+	TransportVlan int64                          `json:"transport_vlan"`
+	Mtu           int32                          `json:"mtu,omitempty"`
+}
+
+func getUplinkHostSwitchTeamingSchema(named bool) map[string]*schema.Schema {
+	elemSchema := map[string]*schema.Schema{
+		"policy": {
+			Type:         schema.TypeString,
+			Description:  "Teaming policy",
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(uplinkHostSwitchTeamingPolicyValues, false),
+		},
+		"active_uplinks": {
+			Type:        schema.TypeList,
+			Description: "Names of active uplinks",
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"standby_uplinks": {
+			Type:        schema.TypeList,
+			Description: "Names of standby uplinks",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	if named {
+		elemSchema["name"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "Name of the teaming policy",
+			Required:    true,
+		}
+	}
+
+	return elemSchema
+}
+
+func resourceNsxtUplinkHostSwitchProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtUplinkHostSwitchProfileCreate,
+		Read:   resourceNsxtUplinkHostSwitchProfileRead,
+		Update: resourceNsxtUplinkHostSwitchProfileUpdate,
+		Delete: resourceNsxtUplinkHostSwitchProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of this resource",
+				Optional:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "The display name of this resource. Defaults to ID if not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag": getTagsSchema(),
+			"teaming": {
+				Type:        schema.TypeList,
+				Description: "Default teaming policy",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: getUplinkHostSwitchTeamingSchema(false),
+				},
+			},
+			"named_teaming": {
+				Type:        schema.TypeList,
+				Description: "Named teaming policies, which can be used by VLAN segments",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: getUplinkHostSwitchTeamingSchema(true),
+				},
+			},
+			"transport_vlan": {
+				Type:         schema.TypeInt,
+				Description:  "VLAN used for tagging overlay traffic",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 4094),
+			},
+			"mtu": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum transmission unit. If not set, global MTU is used",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1280),
+			},
+		},
+	}
+}
+
+func getUplinkHostSwitchUplinks(names []interface{}) []manager.Uplink {
+	var uplinks []manager.Uplink
+	for _, name := range interface2StringList(names) {
+		uplinks = append(uplinks, manager.Uplink{
+			UplinkName: name,
+			UplinkType: uplinkHostSwitchPnicType,
+		})
+	}
+	return uplinks
+}
+
+func getUplinkHostSwitchUplinkNames(uplinks []manager.Uplink) []string {
+	var names []string
+	for _, uplink := range uplinks {
+		names = append(names, uplink.UplinkName)
+	}
+	return names
+}
+
+func getUplinkHostSwitchProfileFromSchema(d *schema.ResourceData) uplinkHostSwitchProfile {
+	teaming := d.Get("teaming").([]interface{})[0].(map[string]interface{})
+	profile := uplinkHostSwitchProfile{
+		Revision:     int64(d.Get("revision").(int)),
+		ResourceType: transportNodeUplinkProfileType,
+		DisplayName:  d.Get("display_name").(string),
+		Description:  d.Get("description").(string),
+		Tags:         getTagsFromSchema(d),
+		Teaming: &manager.TeamingPolicy{
+			Policy:      teaming["policy"].(string),
+			ActiveList:  getUplinkHostSwitchUplinks(teaming["active_uplinks"].([]interface{})),
+			StandbyList: getUplinkHostSwitchUplinks(teaming["standby_uplinks"].([]interface{})),
+		},
+		NamedTeamings: make([]uplinkHostSwitchNamedTeaming, 0),
+		TransportVlan: int64(d.Get("transport_vlan").(int)),
+		Mtu:           int32(d.Get("mtu").(int)),
+	}
+
+	for _, named := range d.Get("named_teaming").([]interface{}) {
+		data := named.(map[string]interface{})
+		profile.NamedTeamings = append(profile.NamedTeamings, uplinkHostSwitchNamedTeaming{
+			Name:        data["name"].(string),
+			Policy:      data["policy"].(string),
+			ActiveList:  getUplinkHostSwitchUplinks(data["active_uplinks"].([]interface{})),
+			StandbyList: getUplinkHostSwitchUplinks(data["standby_uplinks"].([]interface{})),
+		})
+	}
+
+	return profile
+}
+
+func resourceNsxtUplinkHostSwitchProfileCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	err := createHostSwitchProfile(d, m, uplinkHostSwitchProfileName, getUplinkHostSwitchProfileFromSchema(d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtUplinkHostSwitchProfileRead(d, m)
+}
+
+func resourceNsxtUplinkHostSwitchProfileRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	var profile uplinkHostSwitchProfile
+	found, err := readHostSwitchProfile(d, m, uplinkHostSwitchProfileName, &profile)
+	if err != nil || !found {
+		return err
+	}
+
+	d.Set("revision", profile.Revision)
+	d.Set("description", profile.Description)
+	d.Set("display_name", profile.DisplayName)
+	setTagsInSchema(d, profile.Tags)
+	d.Set("transport_vlan", profile.TransportVlan)
+	d.Set("mtu", profile.Mtu)
+
+	var teamings []map[string]interface{}
+	if profile.Teaming != nil {
+		teamings = append(teamings, map[string]interface{}{
+			"policy":          profile.Teaming.Policy,
+			"active_uplinks":  getUplinkHostSwitchUplinkNames(profile.Teaming.ActiveList),
+			"standby_uplinks": getUplinkHostSwitchUplinkNames(profile.Teaming.StandbyList),
+		})
+	}
+	d.Set("teaming", teamings)
+
+	var namedTeamings []map[string]interface{}
+	for _, named := range profile.NamedTeamings {
+		namedTeamings = append(namedTeamings, map[string]interface{}{
+			"name":            named.Name,
+			"policy":          named.Policy,
+			"active_uplinks":  getUplinkHostSwitchUplinkNames(named.ActiveList),
+			"standby_uplinks": getUplinkHostSwitchUplinkNames(named.StandbyList),
+		})
+	}
+	d.Set("named_teaming", namedTeamings)
+
+	return nil
+}
+
+func resourceNsxtUplinkHostSwitchProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	err := updateHostSwitchProfile(d, m, uplinkHostSwitchProfileName, getUplinkHostSwitchProfileFromSchema(d))
+	if err != nil {
+		return err
+	}
+
+	return resourceNsxtUplinkHostSwitchProfileRead(d, m)
+}
+
+func resourceNsxtUplinkHostSwitchProfileDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	return deleteHostSwitchProfile(d, m, uplinkHostSwitchProfileName)
+}
@@ -0,0 +1,169 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtUplinkHostSwitchProfile_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_uplink_host_switch_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtHostSwitchProfileCheckDestroy(state, "nsxt_uplink_host_switch_profile")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtUplinkHostSwitchProfileCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtHostSwitchProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.policy", "FAILOVER_ORDER"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.active_uplinks.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.standby_uplinks.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "named_teaming.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_vlan", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtUplinkHostSwitchProfileUpdateTemplate(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtHostSwitchProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test Update"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.policy", "LOADBALANCE_SRCID"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.active_uplinks.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.standby_uplinks.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "named_teaming.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "named_teaming.0.name", "uplink-1-only"),
+					resource.TestCheckResourceAttr(testResourceName, "named_teaming.0.policy", "FAILOVER_ORDER"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_vlan", "120"),
+					resource.TestCheckResourceAttr(testResourceName, "mtu", "9000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtUplinkHostSwitchProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_uplink_host_switch_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccTestMP(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtHostSwitchProfileCheckDestroy(state, "nsxt_uplink_host_switch_profile")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtUplinkHostSwitchProfileUpdateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtHostSwitchProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Host Switch Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Host Switch Profile resource ID not set in resources")
+		}
+
+		_, err := managerAPIRequest(testAccProvider.Meta(), http.MethodGet, getHostSwitchProfileAPIPath(resourceID), nil, nil)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Host Switch Profile %s: %v", resourceID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtHostSwitchProfileCheckDestroy(state *terraform.State, resourceType string) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		resp, err := managerAPIRequest(testAccProvider.Meta(), http.MethodGet, getHostSwitchProfileAPIPath(resourceID), nil, nil)
+		if isManagerAPINotFound(resp) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Host Switch Profile %s: %v", resourceID, err)
+		}
+
+		return fmt.Errorf("Host Switch Profile %s still exists", resourceID)
+	}
+	return nil
+}
+
+func testAccNsxtUplinkHostSwitchProfileCreateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_uplink_host_switch_profile" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  teaming {
+    policy          = "FAILOVER_ORDER"
+    active_uplinks  = ["uplink-1"]
+    standby_uplinks = ["uplink-2"]
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func testAccNsxtUplinkHostSwitchProfileUpdateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_uplink_host_switch_profile" "test" {
+  display_name   = "%s"
+  description    = "Acceptance Test Update"
+  transport_vlan = 120
+  mtu            = 9000
+
+  teaming {
+    policy         = "LOADBALANCE_SRCID"
+    active_uplinks = ["uplink-1", "uplink-2"]
+  }
+
+  named_teaming {
+    name           = "uplink-1-only"
+    policy         = "FAILOVER_ORDER"
+    active_uplinks = ["uplink-1"]
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
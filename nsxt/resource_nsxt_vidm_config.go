@@ -0,0 +1,150 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNsxtVidmConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtVidmConfigCreate,
+		Read:   resourceNsxtVidmConfigRead,
+		Update: resourceNsxtVidmConfigUpdate,
+		Delete: resourceNsxtVidmConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"host_name": {
+				Type:        schema.TypeString,
+				Description: "Fully Qualified Domain Name of vIDM",
+				Required:    true,
+			},
+			"node_host_name": {
+				Type:        schema.TypeString,
+				Description: "Host name to use when creating the redirect URL for clients to follow after authenticating to vIDM",
+				Required:    true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "vIDM client ID",
+				Required:    true,
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Description: "vIDM client secret",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"thumbprint": {
+				Type:        schema.TypeString,
+				Description: "Hexadecimal SHA256 hash of the vIDM server X.509 certificate",
+				Required:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable vIDM authentication",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+// vidm_enable is omitempty in go-vmware-nsxt model, hence the payload is
+// built as a map so that disabling vIDM is sent to NSX
+func getVidmConfigFromSchema(d *schema.ResourceData, enabled bool) map[string]interface{} {
+	return map[string]interface{}{
+		"host_name":      d.Get("host_name").(string),
+		"node_host_name": d.Get("node_host_name").(string),
+		"client_id":      d.Get("client_id").(string),
+		"client_secret":  d.Get("client_secret").(string),
+		"thumbprint":     d.Get("thumbprint").(string),
+		"vidm_enable":    enabled,
+	}
+}
+
+func updateVidmConfig(d *schema.ResourceData, m interface{}, enabled bool) error {
+	_, err := managerAPIRequest(m, http.MethodPut, "/node/aaa/providers/vidm", getVidmConfigFromSchema(d, enabled), nil)
+	return err
+}
+
+func resourceNsxtVidmConfigCreate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	hostName := d.Get("host_name").(string)
+	log.Printf("[INFO] Configuring vIDM %s", hostName)
+	err := updateVidmConfig(d, m, d.Get("enabled").(bool))
+	if err != nil {
+		return fmt.Errorf("Error during vIDM config create: %v", err)
+	}
+
+	d.SetId(newUUID())
+
+	return resourceNsxtVidmConfigRead(d, m)
+}
+
+func resourceNsxtVidmConfigRead(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	vidmConfig, _, err := nsxClient.NsxComponentAdministrationApi.ReadAuthProviderVidm(nsxClient.Context)
+	if err != nil {
+		return fmt.Errorf("Error during vIDM config read: %v", err)
+	}
+
+	d.Set("host_name", vidmConfig.HostName)
+	d.Set("node_host_name", vidmConfig.NodeHostName)
+	d.Set("client_id", vidmConfig.ClientId)
+	d.Set("thumbprint", vidmConfig.Thumbprint)
+	d.Set("enabled", vidmConfig.VidmEnable)
+	// NOTE: client secret is not returned by NSX
+
+	return nil
+}
+
+func resourceNsxtVidmConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	hostName := d.Get("host_name").(string)
+	log.Printf("[INFO] Updating vIDM config %s", hostName)
+	err := updateVidmConfig(d, m, d.Get("enabled").(bool))
+	if err != nil {
+		return fmt.Errorf("Error during vIDM config update: %v", err)
+	}
+
+	return resourceNsxtVidmConfigRead(d, m)
+}
+
+func resourceNsxtVidmConfigDelete(d *schema.ResourceData, m interface{}) error {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return resourceNotSupportedError()
+	}
+
+	// vIDM configuration can not be removed, hence vIDM authentication
+	// is disabled instead
+	hostName := d.Get("host_name").(string)
+	log.Printf("[INFO] Disabling vIDM %s", hostName)
+	err := updateVidmConfig(d, m, false)
+	if err != nil {
+		return fmt.Errorf("Error during vIDM config delete: %v", err)
+	}
+
+	return nil
+}
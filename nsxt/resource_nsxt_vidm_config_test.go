@@ -0,0 +1,59 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtVidmConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_vidm_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccTestMP(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_VIDM_HOST")
+			testAccEnvDefined(t, "NSXT_TEST_VIDM_CLIENT_ID")
+			testAccEnvDefined(t, "NSXT_TEST_VIDM_CLIENT_SECRET")
+			testAccEnvDefined(t, "NSXT_TEST_VIDM_THUMBPRINT")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNSXVidmConfigTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "host_name", os.Getenv("NSXT_TEST_VIDM_HOST")),
+					resource.TestCheckResourceAttr(testResourceName, "client_id", os.Getenv("NSXT_TEST_VIDM_CLIENT_ID")),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccNSXVidmConfigTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "host_name", os.Getenv("NSXT_TEST_VIDM_HOST")),
+					resource.TestCheckResourceAttr(testResourceName, "client_id", os.Getenv("NSXT_TEST_VIDM_CLIENT_ID")),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNSXVidmConfigTemplate(enabled bool) string {
+	return fmt.Sprintf(`
+resource "nsxt_vidm_config" "test" {
+  host_name      = "%s"
+  node_host_name = "%s"
+  client_id      = "%s"
+  client_secret  = "%s"
+  thumbprint     = "%s"
+  enabled        = %t
+}`, os.Getenv("NSXT_TEST_VIDM_HOST"), os.Getenv("NSXT_MANAGER_HOST"), os.Getenv("NSXT_TEST_VIDM_CLIENT_ID"), os.Getenv("NSXT_TEST_VIDM_CLIENT_SECRET"), os.Getenv("NSXT_TEST_VIDM_THUMBPRINT"), enabled)
+}
@@ -278,6 +278,12 @@ func getPolicyCommonSegmentSchema(vlanRequired bool, isFixed bool) map[string]*s
 			Optional:    true,
 		},
 		"dhcp_config_path": getPolicyPathSchema(false, false, "Policy path to DHCP server or relay configuration to use for subnets configured on this segment"),
+		"metadata_proxy_paths": {
+			Type:        schema.TypeList,
+			Description: "Policy paths to metadata proxy configurations",
+			Optional:    true,
+			Elem:        getElemPolicyPathSchema(),
+		},
 		"transport_zone_path": {
 			Type:         schema.TypeString,
 			Description:  "Policy path to the transport zone",
@@ -653,6 +659,7 @@ func policySegmentResourceToInfraStruct(id string, d *schema.ResourceData, isVla
 	domainName := d.Get("domain_name").(string)
 	tzPath := d.Get("transport_zone_path").(string)
 	dhcpConfigPath := d.Get("dhcp_config_path").(string)
+	metadataProxyPaths := getStringListFromSchemaList(d, "metadata_proxy_paths")
 	revision := int64(d.Get("revision").(int))
 	resourceType := "Segment"
 
@@ -680,6 +687,9 @@ func policySegmentResourceToInfraStruct(id string, d *schema.ResourceData, isVla
 	if dhcpConfigPath != "" && nsxVersionHigherOrEqual("3.0.0") {
 		obj.DhcpConfigPath = &dhcpConfigPath
 	}
+	if nsxVersionHigherOrEqual("3.0.0") {
+		obj.MetadataProxyPaths = metadataProxyPaths
+	}
 
 	var vlanIds []string
 	var subnets []interface{}
@@ -1286,6 +1296,7 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 	}
 	d.Set("dhcp_config_path", obj.DhcpConfigPath)
 	d.Set("domain_name", obj.DomainName)
+	d.Set("metadata_proxy_paths", obj.MetadataProxyPaths)
 	d.Set("transport_zone_path", obj.TransportZonePath)
 
 	d.Set("vlan_ids", obj.VlanIds)
@@ -1536,3 +1547,13 @@ func parseSegmentPolicyPath(path string) (bool, string, string) {
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	return isT0, gwID, segmentID
 }
+
+// Operational data APIs are only available for infra segments
+func getPolicyInfraSegmentIDFromPath(segmentPath string) (string, error) {
+	_, gwID, segmentID := parseSegmentPolicyPath(segmentPath)
+	if segmentID == "" || gwID != "" {
+		return "", fmt.Errorf("Invalid segment path %s, only infra segments are supported", segmentPath)
+	}
+
+	return segmentID, nil
+}
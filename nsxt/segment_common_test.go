@@ -0,0 +1,92 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Matches numeric attribute above zero, such as count of a non-empty list
+var testAccNonZeroNumber = regexp.MustCompile(`^[1-9][0-9]*$`)
+
+var testAccPolicySegmentDataSourceTypes = []string{
+	"nsxt_policy_segment_arp_table",
+	"nsxt_policy_segment_mac_table",
+	"nsxt_policy_segment_tep_table",
+	"nsxt_policy_segment_state",
+	"nsxt_policy_segment_statistics",
+}
+
+func TestGetPolicyInfraSegmentIDFromPath(t *testing.T) {
+	cases := []struct {
+		path       string
+		expectedID string
+		expectErr  bool
+	}{
+		{"/infra/segments/web", "web", false},
+		{"/global-infra/segments/web", "web", false},
+		{"/infra/tier-1s/t1/segments/web", "", true},
+		{"/infra/tier-0s/t0/segments/web", "", true},
+		{"/infra/tier-1s/t1", "", true},
+		{"segments", "", true},
+		{"", "", true},
+	}
+
+	for _, c := range cases {
+		id, err := getPolicyInfraSegmentIDFromPath(c.path)
+		if c.expectErr {
+			if err == nil {
+				t.Errorf("Expected error for path %s, got ID %s", c.path, id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for path %s: %v", c.path, err)
+		}
+		if id != c.expectedID {
+			t.Errorf("Expected ID %s for path %s, got %s", c.expectedID, c.path, id)
+		}
+	}
+}
+
+func TestAccDataSourceNsxtPolicySegmentData_fixedSegment(t *testing.T) {
+	name := getAccTestDataSourceName()
+	tzName := getOverlayTransportZoneName()
+
+	var steps []resource.TestStep
+	for _, dataSourceType := range testAccPolicySegmentDataSourceTypes {
+		steps = append(steps, resource.TestStep{
+			Config:      testAccNsxtPolicyFixedSegmentDataReadTemplate(tzName, name, dataSourceType),
+			ExpectError: regexp.MustCompile(`only infra segments are supported`),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps:     steps,
+	})
+}
+
+// Segment with connected VM is expected to have learned entries and traffic
+func testAccNsxtPolicyVMSegmentDataReadTemplate(dataSourceType string) string {
+	return fmt.Sprintf(`
+data "%s" "test" {
+  segment_path = "/infra/segments/%s"
+}`, dataSourceType, getTestVMSegmentID())
+}
+
+func testAccNsxtPolicyFixedSegmentDataReadTemplate(tzName string, name string, dataSourceType string) string {
+	return testAccNsxtPolicyFixedSegmentImportTemplate(tzName, name) + fmt.Sprintf(`
+data "%s" "test" {
+  segment_path = nsxt_policy_fixed_segment.test.path
+}`, dataSourceType)
+}
@@ -0,0 +1,274 @@
+/* Copyright © 2021 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vmware-nsxt/manager"
+)
+
+// go-vmware-nsxt models host switch spec and IP assignment spec as base
+// types without subtype fields. Transport node host switches are sent and
+// read with the types below instead.
+
+type transportNodeIPAssignmentSpec struct {
+	ResourceType string `json:"resource_type"`
+	IPPoolID     string `json:"ip_pool_id,omitempty"`
+}
+
+type transportNodeVdsUplink struct {
+	UplinkName    string `json:"uplink_name"`
+	VdsUplinkName string `json:"vds_uplink_name"`
+}
+
+type transportNodeHostSwitch struct {
+	HostSwitchName         string                                 `json:"host_switch_name,omitempty"`
+	HostSwitchMode         string                                 `json:"host_switch_mode,omitempty"`
+	HostSwitchType         string                                 `json:"host_switch_type,omitempty"`
+	HostSwitchID           string                                 `json:"host_switch_id,omitempty"`
+	Uplinks                []transportNodeVdsUplink               `json:"uplinks,omitempty"`
+	HostSwitchProfileIDs   []manager.HostSwitchProfileTypeIdEntry `json:"host_switch_profile_ids,omitempty"`
+	Pnics                  []manager.Pnic                         `json:"pnics,omitempty"`
+	IPAssignmentSpec       *transportNodeIPAssignmentSpec         `json:"ip_assignment_spec,omitempty"`
+	TransportZoneEndpoints []manager.TransportZoneEndPoint        `json:"transport_zone_endpoints,omitempty"`
+}
+
+type transportNodeHostSwitchSpec struct {
+	ResourceType string                    `json:"resource_type"`
+	HostSwitches []transportNodeHostSwitch `json:"host_switches"`
+}
+
+const (
+	transportNodeStandardHostSwitchSpec = "StandardHostSwitchSpec"
+	transportNodeStaticIPPoolSpec       = "StaticIpPoolSpec"
+	transportNodeDhcpIPSpec             = "AssignedByDhcp"
+	transportNodeUplinkProfileType      = "UplinkHostSwitchProfile"
+	transportNodeLldpProfileType        = "LldpHostSwitchProfile"
+	transportNodeNvdsHostSwitchType     = "NVDS"
+	transportNodeVdsHostSwitchType      = "VDS"
+	defaultTransportNodeHostSwitchName  = "nsxDefaultHostSwitch"
+)
+
+var transportNodeHostSwitchModeValues = []string{"STANDARD", "ENS", "ENS_INTERRUPT"}
+var transportNodeHostSwitchTypeValues = []string{transportNodeNvdsHostSwitchType, transportNodeVdsHostSwitchType}
+
+// Host transport nodes may use VDS switches and ENS modes, which are not
+// applicable to edge nodes
+func getTransportNodeHostSwitchSchema(hostNode bool) *schema.Schema {
+	elemSchema := map[string]*schema.Schema{
+		"host_switch_name": {
+			Type:        schema.TypeString,
+			Description: "Name of the host switch",
+			Optional:    true,
+			Default:     defaultTransportNodeHostSwitchName,
+		},
+		"uplink_profile_id": {
+			Type:        schema.TypeString,
+			Description: "ID of uplink host switch profile",
+			Required:    true,
+		},
+		"pnic": {
+			Type:        schema.TypeList,
+			Description: "Physical NICs connected to the host switch",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"device_name": {
+						Type:        schema.TypeString,
+						Description: "Device name or key of the physical NIC",
+						Required:    true,
+					},
+					"uplink_name": {
+						Type:        schema.TypeString,
+						Description: "Uplink name from uplink profile",
+						Required:    true,
+					},
+				},
+			},
+		},
+		"ip_pool_id": {
+			Type:        schema.TypeString,
+			Description: "ID of IP pool for tunnel endpoints. If not set, DHCP is used",
+			Optional:    true,
+		},
+		"transport_zone_ids": {
+			Type:        schema.TypeList,
+			Description: "IDs of transport zones the host switch is attached to",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"lldp_profile_id": {
+			Type:        schema.TypeString,
+			Description: "ID of LLDP host switch profile",
+			Optional:    true,
+			Computed:    true,
+		},
+	}
+
+	if hostNode {
+		elemSchema["host_switch_mode"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Description:  "Operational mode of the host switch",
+			Optional:     true,
+			Default:      "STANDARD",
+			ValidateFunc: validation.StringInSlice(transportNodeHostSwitchModeValues, false),
+		}
+		elemSchema["host_switch_type"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Description:  "Type of the host switch",
+			Optional:     true,
+			Default:      transportNodeNvdsHostSwitchType,
+			ValidateFunc: validation.StringInSlice(transportNodeHostSwitchTypeValues, false),
+		}
+		elemSchema["host_switch_id"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "vCenter UUID of the VDS, required for VDS host switch",
+			Optional:    true,
+		}
+		elemSchema["uplink"] = &schema.Schema{
+			Type:        schema.TypeList,
+			Description: "Mapping of uplink profile uplinks to VDS uplinks",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"uplink_name": {
+						Type:        schema.TypeString,
+						Description: "Uplink name from uplink profile",
+						Required:    true,
+					},
+					"vds_uplink_name": {
+						Type:        schema.TypeString,
+						Description: "Uplink name of the VDS",
+						Required:    true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Host switches of the transport node",
+		Required:    true,
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: elemSchema,
+		},
+	}
+}
+
+func getTransportNodeHostSwitchSpecFromSchema(d *schema.ResourceData, hostNode bool) transportNodeHostSwitchSpec {
+	spec := transportNodeHostSwitchSpec{
+		ResourceType: transportNodeStandardHostSwitchSpec,
+	}
+	for _, hs := range d.Get("host_switch").([]interface{}) {
+		data := hs.(map[string]interface{})
+		hostSwitch := transportNodeHostSwitch{
+			HostSwitchName: data["host_switch_name"].(string),
+			HostSwitchProfileIDs: []manager.HostSwitchProfileTypeIdEntry{{
+				Key:   transportNodeUplinkProfileType,
+				Value: data["uplink_profile_id"].(string),
+			}},
+			IPAssignmentSpec: &transportNodeIPAssignmentSpec{
+				ResourceType: transportNodeDhcpIPSpec,
+			},
+		}
+		if lldpProfileID := data["lldp_profile_id"].(string); lldpProfileID != "" {
+			hostSwitch.HostSwitchProfileIDs = append(hostSwitch.HostSwitchProfileIDs, manager.HostSwitchProfileTypeIdEntry{
+				Key:   transportNodeLldpProfileType,
+				Value: lldpProfileID,
+			})
+		}
+		if hostNode {
+			hostSwitch.HostSwitchMode = data["host_switch_mode"].(string)
+			hostSwitch.HostSwitchType = data["host_switch_type"].(string)
+			hostSwitch.HostSwitchID = data["host_switch_id"].(string)
+			for _, uplink := range data["uplink"].([]interface{}) {
+				uplinkData := uplink.(map[string]interface{})
+				hostSwitch.Uplinks = append(hostSwitch.Uplinks, transportNodeVdsUplink{
+					UplinkName:    uplinkData["uplink_name"].(string),
+					VdsUplinkName: uplinkData["vds_uplink_name"].(string),
+				})
+			}
+		}
+		if poolID := data["ip_pool_id"].(string); poolID != "" {
+			hostSwitch.IPAssignmentSpec = &transportNodeIPAssignmentSpec{
+				ResourceType: transportNodeStaticIPPoolSpec,
+				IPPoolID:     poolID,
+			}
+		}
+		for _, pnic := range data["pnic"].([]interface{}) {
+			pnicData := pnic.(map[string]interface{})
+			hostSwitch.Pnics = append(hostSwitch.Pnics, manager.Pnic{
+				DeviceName: pnicData["device_name"].(string),
+				UplinkName: pnicData["uplink_name"].(string),
+			})
+		}
+		for _, tzID := range interface2StringList(data["transport_zone_ids"].([]interface{})) {
+			hostSwitch.TransportZoneEndpoints = append(hostSwitch.TransportZoneEndpoints, manager.TransportZoneEndPoint{
+				TransportZoneId: tzID,
+			})
+		}
+		spec.HostSwitches = append(spec.HostSwitches, hostSwitch)
+	}
+
+	return spec
+}
+
+func setTransportNodeHostSwitchSpecInSchema(d *schema.ResourceData, spec *transportNodeHostSwitchSpec, hostNode bool) error {
+	var hostSwitches []map[string]interface{}
+	if spec != nil {
+		for _, hostSwitch := range spec.HostSwitches {
+			elem := make(map[string]interface{})
+			elem["host_switch_name"] = hostSwitch.HostSwitchName
+			for _, profile := range hostSwitch.HostSwitchProfileIDs {
+				if profile.Key == transportNodeUplinkProfileType {
+					elem["uplink_profile_id"] = profile.Value
+				}
+				if profile.Key == transportNodeLldpProfileType {
+					elem["lldp_profile_id"] = profile.Value
+				}
+			}
+			if hostNode {
+				elem["host_switch_mode"] = hostSwitch.HostSwitchMode
+				// Older NSX versions only support N-VDS and omit the type
+				elem["host_switch_type"] = hostSwitch.HostSwitchType
+				if hostSwitch.HostSwitchType == "" {
+					elem["host_switch_type"] = transportNodeNvdsHostSwitchType
+				}
+				elem["host_switch_id"] = hostSwitch.HostSwitchID
+				var uplinks []map[string]interface{}
+				for _, uplink := range hostSwitch.Uplinks {
+					uplinks = append(uplinks, map[string]interface{}{
+						"uplink_name":     uplink.UplinkName,
+						"vds_uplink_name": uplink.VdsUplinkName,
+					})
+				}
+				elem["uplink"] = uplinks
+			}
+			if hostSwitch.IPAssignmentSpec != nil {
+				elem["ip_pool_id"] = hostSwitch.IPAssignmentSpec.IPPoolID
+			}
+			var pnics []map[string]interface{}
+			for _, pnic := range hostSwitch.Pnics {
+				pnics = append(pnics, map[string]interface{}{
+					"device_name": pnic.DeviceName,
+					"uplink_name": pnic.UplinkName,
+				})
+			}
+			elem["pnic"] = pnics
+			var tzIDs []string
+			for _, endpoint := range hostSwitch.TransportZoneEndpoints {
+				tzIDs = append(tzIDs, endpoint.TransportZoneId)
+			}
+			elem["transport_zone_ids"] = tzIDs
+			hostSwitches = append(hostSwitches, elem)
+		}
+	}
+
+	return d.Set("host_switch", hostSwitches)
+}
@@ -9,6 +9,7 @@ import (
 	"hash/crc32"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -655,6 +656,10 @@ func handlePagination(lister func(*paginationInfo) error) (int64, error) {
 			}
 		}
 		count += info.PageCount
+		if info.Cursor == "" || info.PageCount == 0 {
+			// last page
+			break
+		}
 		log.Printf("[DEBUG] Fetching next page after %d/%d inspected", count, total)
 
 		info.LocalVarOptionals["cursor"] = info.Cursor
@@ -662,3 +667,32 @@ func handlePagination(lister func(*paginationInfo) error) (int64, error) {
 
 	return total, nil
 }
+
+// Helpers for using handlePagination with Policy API list results
+func getPaginationCursor(info *paginationInfo) *string {
+	if info.Cursor == "" {
+		return nil
+	}
+	return &info.Cursor
+}
+
+func setPaginationInfo(info *paginationInfo, pageCount int, resultCount *int64, cursor *string) {
+	info.PageCount = int64(pageCount)
+	if resultCount != nil {
+		info.TotalCount = *resultCount
+	}
+	if cursor != nil {
+		info.Cursor = *cursor
+	} else {
+		info.Cursor = ""
+	}
+}
+
+// Formats NSX timestamp (epoch milliseconds) as RFC3339 string
+func formatEpochMillisTime(epochMillis *int64) string {
+	if epochMillis == nil {
+		return ""
+	}
+
+	return time.Unix(0, *epochMillis*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+}
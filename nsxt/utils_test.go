@@ -492,3 +492,30 @@ func testAccNsxtPolicyResourceCheckDestroy(state *terraform.State, displayName s
 	}
 	return nil
 }
+
+func TestHandlePaginationNilCursor(t *testing.T) {
+	calls := 0
+	// Total count is overstated, and the last page does not carry a cursor
+	lister := func(info *paginationInfo) error {
+		calls++
+		if calls > 2 {
+			return fmt.Errorf("Pagination did not stop after last page")
+		}
+		var cursor *string
+		if calls == 1 {
+			next := "00011"
+			cursor = &next
+		}
+		resultCount := int64(20)
+		setPaginationInfo(info, 5, &resultCount, cursor)
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("Expected 2 pages to be fetched, got %d", calls)
+	}
+}
@@ -6,6 +6,7 @@ package nsxt
 import (
 	"fmt"
 	"net"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -475,6 +476,13 @@ func validateNsxtProviderHostFormat() schema.SchemaValidateFunc {
 	}
 }
 
+func validateLicenseKey() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(
+			"^[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}$"),
+		"Must be a valid nsx license key matching: ^[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}-[A-Z0-9]{5}$")
+}
+
 func validateASPlainOrDot(i interface{}, k string) (s []string, es []error) {
 	v, ok := i.(string)
 	if !ok {